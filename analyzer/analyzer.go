@@ -0,0 +1,114 @@
+// Package analyzer exposes the doccheck checks as a go/analysis analyzer,
+// so they can run in golangci-lint and the other analysis drivers.
+//
+// The checks are run by linter.Lint over the files of every analyzed
+// package, the findings are reported as the analysis diagnostics with
+// the check names as their categories and with the suggested fixes.
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+
+	"github.com/Quasilyte/doccheck/linter"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer runs the checks with the options of its flags:
+// -config is a doccheck JSON config file, and -all enables
+// the checks of the unexported declarations.
+var Analyzer = New(linter.Options{})
+
+// New returns an analyzer that runs the checks with the options,
+// the analyzer flags override them.
+func New(opts linter.Options) *analysis.Analyzer {
+	a := &analysis.Analyzer{
+		Name: "doccheck",
+		Doc:  "checks the Go doc-comments",
+		URL:  "https://github.com/Quasilyte/doccheck",
+	}
+	configFile := a.Flags.String("config", "", "doccheck JSON config `file`, like .doccheck.json")
+	a.Flags.BoolVar(&opts.All, "all", opts.All, "check the docs of the unexported declarations too")
+	a.Run = func(pass *analysis.Pass) (interface{}, error) {
+		opts := opts
+		if *configFile != "" {
+			data, err := os.ReadFile(*configFile)
+			if err != nil {
+				return nil, err
+			}
+			opts.Config, err = linter.ParseConfig(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", *configFile, err)
+			}
+		}
+		return nil, run(pass, opts)
+	}
+	return a
+}
+
+func run(pass *analysis.Pass, opts linter.Options) error {
+	readFile := pass.ReadFile
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+	files := make(map[string]*ast.File, len(pass.Files))
+	opts.Sources = make(map[string][]byte, len(pass.Files))
+	for _, f := range pass.Files {
+		filename := pass.Fset.Position(f.Package).Filename
+		files[filename] = f
+		if src, err := readFile(filename); err == nil {
+			opts.Sources[filename] = src
+		}
+	}
+
+	diagnostics, err := linter.Lint(pass.Fset, pass.Files, opts)
+	if err != nil {
+		return err
+	}
+	for _, d := range diagnostics {
+		if d.Suppression != nil {
+			continue
+		}
+		f := files[d.Pos.Filename]
+		if f == nil {
+			// The package-level findings may refer to the directory.
+			f = pass.Files[0]
+		}
+		label := d.Check
+		if d.ID != "" {
+			label = d.ID + " " + d.Check
+		}
+		diag := analysis.Diagnostic{
+			Pos:      position(pass.Fset, f, d.Pos),
+			Category: d.Check,
+			Message:  fmt.Sprintf("%s (%s)", d.Message, label),
+		}
+		if d.End.IsValid() {
+			diag.End = position(pass.Fset, f, d.End)
+		}
+		for _, fix := range d.Fixes {
+			diag.SuggestedFixes = append(diag.SuggestedFixes, analysis.SuggestedFix{
+				Message: d.Message,
+				TextEdits: []analysis.TextEdit{{
+					Pos:     position(pass.Fset, f, fix.Pos),
+					End:     position(pass.Fset, f, fix.End),
+					NewText: []byte(fix.NewText),
+				}},
+			})
+		}
+		pass.Report(diag)
+	}
+	return nil
+}
+
+// position returns the file position of the offset of p,
+// or the package clause if p has neither a line nor an offset.
+func position(fset *token.FileSet, f *ast.File, p token.Position) token.Pos {
+	tf := fset.File(f.Package)
+	if tf == nil || p.Line == 0 && p.Offset == 0 || p.Offset > tf.Size() {
+		return f.Package
+	}
+	return tf.Pos(p.Offset)
+}
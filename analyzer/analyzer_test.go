@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestAnalyzer(t *testing.T) {
+	src := "package p\n\n// Parse parses the config. \nfunc Parse() {}\n"
+	filename := filepath.Join(t.TempDir(), "p.go")
+	if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diagnostics := make(map[string]analysis.Diagnostic)
+	pass := &analysis.Pass{
+		Analyzer: Analyzer,
+		Fset:     fset,
+		Files:    []*ast.File{f},
+		ReadFile: os.ReadFile,
+		Report: func(d analysis.Diagnostic) {
+			diagnostics[d.Category] = d
+		},
+	}
+	if _, err := Analyzer.Run(pass); err != nil {
+		t.Fatal(err)
+	}
+
+	d, ok := diagnostics["package-doc"]
+	switch {
+	case !ok:
+		t.Errorf("package-doc is not reported: %v", diagnostics)
+	case d.Pos != f.Package:
+		t.Errorf("package-doc is reported at %v, want the package clause", fset.Position(d.Pos))
+	}
+
+	d, ok = diagnostics["trailing-space"]
+	if !ok {
+		t.Fatalf("trailing-space is not reported: %v", diagnostics)
+	}
+	if pos := fset.Position(d.Pos); pos.Line != 3 {
+		t.Errorf("trailing-space is reported at %v, want line 3", pos)
+	}
+	if !strings.HasSuffix(d.Message, "(DC024 trailing-space)") {
+		t.Errorf("trailing-space message %q has no check label", d.Message)
+	}
+	if len(d.SuggestedFixes) != 1 || len(d.SuggestedFixes[0].TextEdits) != 1 {
+		t.Fatalf("trailing-space fixes: %+v, want a single edit", d.SuggestedFixes)
+	}
+	edit := d.SuggestedFixes[0].TextEdits[0]
+	from, to := fset.Position(edit.Pos).Offset, fset.Position(edit.End).Offset
+	if fixed := src[:from] + string(edit.NewText) + src[to:]; fixed != strings.Replace(src, "config. \n", "config.\n", 1) {
+		t.Errorf("fixed source:\n%s", fixed)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := analysis.Validate([]*analysis.Analyzer{Analyzer}); err != nil {
+		t.Fatal(err)
+	}
+}
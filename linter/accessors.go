@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+)
+
+// checkAccessorDoc checks the getter and setter doc-comments
+// follow the "X returns" and "SetX sets" forms.
+//
+// Getters are the methods without parameters that return
+// a single value, so many of them are not the field accessors.
+// That is why the check is disabled by default.
+func (c *checker) checkAccessorDoc(doc *ast.CommentGroup) {
+	fn := c.current.fn
+	if !c.accessorDocs || fn.Recv == nil {
+		return
+	}
+	name := fn.Name.Name
+	params := fn.Type.Params.NumFields()
+	results := fn.Type.Results.NumFields()
+
+	var verb string
+	switch {
+	case isAccessorName(name, "Set") && params == 1 && results == 0:
+		verb = "sets"
+	case params == 0 && results == 1 && c.boolFuncType(fn) == notBoolFunc:
+		verb = "returns"
+	default:
+		return
+	}
+
+	sentences := c.tokenizer.Sentences(doc.Text())
+	if len(sentences) == 0 {
+		return
+	}
+	rest, ok := strings.CutPrefix(normalizeSpace(sentences[0].Text), name+" ")
+	if !ok {
+		return
+	}
+	switch {
+	case strings.HasPrefix(rest, "reports whether "):
+		c.warnFuncRange("accessor-doc", doc.List[0].Pos(), doc.List[0].End(), "%s is not a predicate, its doc-comment should be in the %q form", name, name+" "+verb+" ...")
+	case isAccessorName(name, "Get") && (strings.HasPrefix(rest, "returns a new ") || strings.HasPrefix(rest, "creates ")):
+		// Methods like Clone do return new objects, but
+		// GetX is expected to return the existing X.
+		c.warnFuncRange("accessor-doc", doc.List[0].Pos(), doc.List[0].End(), "%s is not a constructor, its doc-comment should be in the %q form", name, name+" "+verb+" ...")
+	case !strings.HasPrefix(rest, verb+" "):
+		c.warnFuncRange("accessor-doc", doc.List[0].Pos(), doc.List[0].End(), "accessor doc-comment should be in the %q form", name+" "+verb+" ...")
+	}
+}
+
+// isAccessorName reports whether name is prefix followed by
+// an exported name, like SetName for the "Set" prefix.
+func isAccessorName(name, prefix string) bool {
+	suffix, ok := strings.CutPrefix(name, prefix)
+	return ok && suffix != "" && unicode.IsUpper(rune(suffix[0]))
+}
@@ -0,0 +1,77 @@
+package linter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+)
+
+// checkTypeAliases checks that the exported type aliases have
+// their own doc-comments. With the type information, it also
+// reports the alias docs copied from the aliased type, as go/doc
+// shows the alias separately and the copy hides the fact it's an alias.
+func (c *checker) checkTypeAliases(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.TypeSpec)
+			if !spec.Assign.IsValid() || !spec.Name.IsExported() {
+				continue
+			}
+			doc := spec.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+			if doc == nil {
+				c.warnIdent("type-alias", spec.Name, "exported type alias %s should have a doc-comment", spec.Name.Name)
+				continue
+			}
+			target, targetDoc := c.aliasTargetDoc(spec)
+			if targetDoc == "" {
+				continue
+			}
+			names := []string{spec.Name.Name, target.Name()}
+			if slices.Equal(docWords(doc.Text(), names...), docWords(targetDoc, names...)) {
+				c.warnGroup("type-alias", doc, "doc-comment is copied from %s.%s, describe the alias instead",
+					target.Pkg().Name(), target.Name())
+			}
+		}
+	}
+}
+
+// aliasTargetDoc returns the aliased named type and its doc-comment text.
+// The doc is empty if the type information is unavailable.
+func (c *checker) aliasTargetDoc(spec *ast.TypeSpec) (*types.TypeName, string) {
+	if c.types == nil {
+		return nil, ""
+	}
+	obj, ok := c.types.Defs[spec.Name].(*types.TypeName)
+	if !ok {
+		return nil, ""
+	}
+	named, ok := types.Unalias(obj.Type()).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return nil, ""
+	}
+	target := named.Obj()
+	filename := c.fset.Position(target.Pos()).Filename
+	if filename == "" {
+		return nil, ""
+	}
+	// The importer doesn't keep the comments, so the file is parsed again.
+	f, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, ""
+	}
+	for _, d := range fileDeclDocs(f) {
+		if d.name == target.Name() && d.recv == "" {
+			return target, d.doc.Text()
+		}
+	}
+	return nil, ""
+}
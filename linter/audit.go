@@ -0,0 +1,274 @@
+package linter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// auditModule is a single module audit results.
+type auditModule struct {
+	Path     string         `json:"path"`
+	Dir      string         `json:"dir"`
+	Packages int            `json:"packages"`
+	Issues   []auditIssue   `json:"issues"`
+	ByCheck  map[string]int `json:"by_check"`
+
+	// packageDirs are checked package directories.
+	packageDirs []string
+}
+
+type auditIssue struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// audit checks every module found under the roots and writes
+// per-module reports along with the top-level index to outDir.
+//
+// All module packages are checked by a single worker pool,
+// so large modules don't delay the smaller ones.
+func (l *linter) audit(roots []string, outDir string) error {
+	var modules []*auditModule
+	for _, root := range roots {
+		found, err := findModules(root, l.skipDir)
+		if err != nil {
+			return err
+		}
+		modules = append(modules, found...)
+	}
+	if len(modules) == 0 {
+		return fmt.Errorf("no go.mod files found")
+	}
+
+	var paths []string
+	for _, m := range modules {
+		paths = append(paths, m.packageDirs...)
+	}
+	l.sink = func(iss issue, sev severity) {
+		m := ownerModule(modules, iss.Pos.Filename)
+		if m == nil {
+			return
+		}
+		m.Issues = append(m.Issues, auditIssue{
+			File:     iss.Pos.Filename,
+			Line:     iss.Pos.Line,
+			Column:   iss.Pos.Column,
+			Check:    iss.Check,
+			Severity: sev.String(),
+			Message:  iss.Message,
+		})
+		m.ByCheck[iss.Check]++
+	}
+	l.Run(paths)
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+	for _, m := range modules {
+		sort.SliceStable(m.Issues, func(i, j int) bool {
+			a, b := m.Issues[i], m.Issues[j]
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		})
+		name := auditReportName(m.Path)
+		if err := writeJSONFile(filepath.Join(outDir, name+".json"), m); err != nil {
+			return err
+		}
+		if err := writeHTMLFile(filepath.Join(outDir, name+".html"), moduleReportTemplate, m); err != nil {
+			return err
+		}
+	}
+
+	type indexEntry struct {
+		Path     string `json:"path"`
+		Packages int    `json:"packages"`
+		Issues   int    `json:"issues"`
+		Report   string `json:"report"`
+	}
+	index := make([]indexEntry, len(modules))
+	for i, m := range modules {
+		index[i] = indexEntry{
+			Path:     m.Path,
+			Packages: m.Packages,
+			Issues:   len(m.Issues),
+			Report:   auditReportName(m.Path),
+		}
+	}
+	if err := writeJSONFile(filepath.Join(outDir, "index.json"), index); err != nil {
+		return err
+	}
+	return writeHTMLFile(filepath.Join(outDir, "index.html"), indexReportTemplate, index)
+}
+
+// ownerModule returns the innermost module that contains filename.
+func ownerModule(modules []*auditModule, filename string) *auditModule {
+	var owner *auditModule
+	for _, m := range modules {
+		if filename != m.Dir && !strings.HasPrefix(filename, m.Dir+string(filepath.Separator)) {
+			continue
+		}
+		if owner == nil || len(m.Dir) > len(owner.Dir) {
+			owner = m
+		}
+	}
+	return owner
+}
+
+// findModules returns all modules under root with their package dirs.
+// Nested modules own their own packages. Directories
+// that skip reports are not traversed.
+func findModules(root string, skip func(name string) bool) ([]*auditModule, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []*auditModule
+	byDir := make(map[string]*auditModule)
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && skip(d.Name()) {
+			return filepath.SkipDir
+		}
+
+		modPath, err := readModulePath(filepath.Join(path, "go.mod"))
+		if err == nil {
+			m := &auditModule{Path: modPath, Dir: path, ByCheck: make(map[string]int)}
+			modules = append(modules, m)
+			byDir[path] = m
+		}
+
+		if hasGoFiles(path) {
+			for dir := path; ; dir = filepath.Dir(dir) {
+				if m := byDir[dir]; m != nil {
+					m.packageDirs = append(m.packageDirs, path)
+					m.Packages++
+					break
+				}
+				if dir == root || dir == filepath.Dir(dir) {
+					break
+				}
+			}
+		}
+		return nil
+	})
+	return modules, err
+}
+
+func hasGoFiles(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".go") {
+			return true
+		}
+	}
+	return false
+}
+
+// readModulePath returns the module path declared in the go.mod file.
+func readModulePath(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module directive", filename)
+}
+
+// auditReportName converts module path to a report file name.
+func auditReportName(modPath string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(modPath)
+}
+
+func writeJSONFile(filename string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+func writeHTMLFile(filename string, tmpl *template.Template, data interface{}) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(f, data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+var indexReportTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>doccheck audit</title></head>
+<body>
+<h1>doccheck audit</h1>
+<table>
+<tr><th>Module</th><th>Packages</th><th>Issues</th></tr>
+{{- range .}}
+<tr><td><a href="{{.Report}}.html">{{.Path}}</a></td><td>{{.Packages}}</td><td>{{.Issues}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+var moduleReportTemplate = template.Must(template.New("module").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Path}} - doccheck audit</title></head>
+<body>
+<h1>{{.Path}}</h1>
+<p><a href="index.html">All modules</a></p>
+<p>{{.Packages}} packages, {{len .Issues}} issues.</p>
+{{- if .ByCheck}}
+<h2>Issues per check</h2>
+<ul>
+{{- range $check, $n := .ByCheck}}
+<li>{{$check}}: {{$n}}</li>
+{{- end}}
+</ul>
+{{- end}}
+<h2>Issues</h2>
+<table>
+<tr><th>Location</th><th>Severity</th><th>Check</th><th>Message</th></tr>
+{{- range .Issues}}
+<tr><td>{{.File}}{{if .Line}}:{{.Line}}:{{.Column}}{{end}}</td><td>{{.Severity}}</td><td>{{.Check}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
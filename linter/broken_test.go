@@ -0,0 +1,43 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestBrokenFileFuncDoc(t *testing.T) {
+	dir := t.TempDir()
+	src := `// Package p is a test.
+package p
+
+// Foo does things
+func Foo() { x := }
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	var checks []string
+	l.sink = func(iss issue, sev severity) {
+		checks = append(checks, iss.Check)
+	}
+	l.checkDir(dir)
+	for _, check := range []string{"parse", "ends-with-punct"} {
+		if !slices.Contains(checks, check) {
+			t.Errorf("%s is not reported for the broken file: %v", check, checks)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package linter
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// isBuildConstraint reports whether the comment is a //go:build
+// or a legacy // +build constraint line.
+func isBuildConstraint(text string) bool {
+	return strings.HasPrefix(text, "//go:build ") || strings.HasPrefix(text, "// +build ")
+}
+
+// checkBuildConstraints checks the build constraints layout relative
+// to the package doc. The constraints only work before the package
+// clause and when followed by an empty line, otherwise they're ignored
+// and become a part of the package doc. The package doc in its turn
+// must be right above the package clause, after the constraints.
+func (c *checker) checkBuildConstraints(f *ast.File) {
+	pkgLine := c.fset.Position(f.Package).Line
+	for _, group := range f.Comments {
+		if group.Pos() > f.Package {
+			for _, comment := range group.List {
+				if isBuildConstraint(comment.Text) {
+					c.warnComment("build-constraint", comment, "build constraint after the package clause is ignored, move it to the top of the file")
+				}
+			}
+			continue
+		}
+
+		// The last line of the first constraints block of the group.
+		var last *ast.Comment
+		var text []*ast.Comment
+		for _, comment := range group.List {
+			switch {
+			case isBuildConstraint(comment.Text):
+				if len(text) == 0 {
+					last = comment
+				}
+			case commentLine(comment) != "" && !isDirective(comment.Text):
+				text = append(text, comment)
+			}
+		}
+
+		attached := c.fset.Position(group.End()).Line+1 == pkgLine
+		switch {
+		case last != nil && attached:
+			end := c.fset.Position(last.End())
+			c.suggestFix(textEdit{check: "build-constraint", filename: end.Filename, start: end.Offset, end: end.Offset, newText: "\n"})
+			c.warnComment("build-constraint", last, "build constraint is ignored and pollutes the package doc, follow it with an empty line")
+		case !attached && len(text) != 0 && strings.HasPrefix(commentLine(text[0]), "Package "+f.Name.Name+" "):
+			c.warnComment("build-constraint", text[0], "package doc is detached from the package clause, move it below the build constraints")
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package linter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// cacheVersion must be changed whenever the checks behavior changes,
+// so the results produced by the older versions are not reused.
+const cacheVersion = "66"
+
+// resultCache stores per-file check results on disk, so the files
+// that were not changed since the last run don't need to be checked again.
+type resultCache struct {
+	dir string
+}
+
+// checksFingerprint describes the parameters that affect the check results.
+func (c *checker) checksFingerprint() string {
+	fp := fmt.Sprintf("max-first-paragraph-sentences=%d identifier-style=%s command-usage=%v doc-go=%+v max-synopsis-length=%d duplicate-doc-similarity=%v types=%v receiver-name=%v accessor-docs=%v error-docs=%v parse-code-blocks=%v type-param-docs=%d tests=%v exported-only=%v include-generated=%v",
+		c.maxFirstParagraphSentences, c.identStyle, c.commandUsage, c.docGo, c.maxSynopsisLength,
+		c.duplicateDocSimilarity, c.typeInfo, c.receiverName, c.accessorDocs, c.errorDocs, c.parseCodeBlocks, c.typeParamDocs, c.tests, c.exportedOnly, c.includeGenerated)
+	fp += fmt.Sprintf(" predicate-prefixes=%q predicate-phrases=%q predicate-exempt=%q",
+		c.predicatePrefixes, c.predicatePhrases, c.predicateExempt)
+	for _, rule := range c.customRules {
+		fp += fmt.Sprintf(" rule=%q,%q,%d,%v,%q", rule.name, rule.re, rule.target, rule.sentence, rule.message)
+	}
+	for _, check := range c.checks {
+		fp += fmt.Sprintf(" check=%q", check.Name())
+	}
+	fp += " initialisms=" + strings.Join(sortedKeys(c.initialisms), ",") + " spelling=" + c.spelling
+	fp += fmt.Sprintf(" wrap-width=%d language=%s sentence-spacing=%s", c.wrapWidth, c.language, c.sentenceSpacing)
+	if c.fillerWords != nil {
+		fp += " filler-words=" + c.fillerWords.String()
+	}
+	if t, ok := c.tokenizer.(*proseTokenizer); ok {
+		abbreviations := make([]string, 0, len(t.abbreviations))
+		for abbr := range t.abbreviations {
+			abbreviations = append(abbreviations, abbr)
+		}
+		sort.Strings(abbreviations)
+		fp += " abbreviations=" + strings.Join(abbreviations, ",")
+	}
+	return fp
+}
+
+func (c *checker) cacheKey(filename string, src []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00", cacheVersion, c.checksFingerprint(), filename)
+	h.Write(src)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fileCacheKey returns a key for the file results.
+// The file results depend on the other package files too,
+// like the names they declare or the -types information,
+// so the package key is a part of it.
+func (c *checker) fileCacheKey(filename string, src []byte, pkgKey string, words *wordList) string {
+	key := c.cacheKey(filename, src)
+	if words != nil {
+		key = c.cacheKey(filename, []byte(key+words.key))
+	}
+	return c.cacheKey(filename, []byte(key+pkgKey))
+}
+
+// packageCacheKey returns a key for the package-level results
+// of the package that consists of the given files.
+func (c *checker) packageCacheKey(filenames []string, sources map[string][]byte, words *wordList, importPath string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00owned-by=%s\x00import-path=%s\x00", cacheVersion, c.checksFingerprint(), c.ownedBy, importPath)
+	if words != nil {
+		fmt.Fprintf(h, "words=%s\x00", words.key)
+	}
+	for _, filename := range filenames {
+		sum := sha256.Sum256(sources[filename])
+		fmt.Fprintf(h, "%s\x00%x\x00", filename, sum)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *resultCache) load(key string) ([]issue, bool) {
+	data, err := os.ReadFile(filepath.Join(c.dir, key))
+	if err != nil {
+		return nil, false
+	}
+	var issues []issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return nil, false
+	}
+	return issues, true
+}
+
+func (c *resultCache) store(key string, issues []issue) error {
+	if issues == nil {
+		issues = []issue{}
+	}
+	data, err := json.Marshal(issues)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so concurrent runs
+	// never observe a partially written entry.
+	tmp, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(c.dir, key))
+}
+
+// checkFileCached runs check for the given file unless its results
+// were loaded from the cache. Fresh results are stored to the cache.
+func (c *checker) checkFileCached(filename string, check func()) {
+	if issues, ok := c.cached[filename]; ok {
+		c.replay(issues)
+		return
+	}
+	if c.cache == nil {
+		check()
+		return
+	}
+	c.logger.Debug("cache miss", "file", filename)
+	c.runCached(c.fileCacheKey(filename, c.sources[filename], c.pkgKey, c.words), check)
+}
+
+// runCached runs check and stores its results under the given key.
+func (c *checker) runCached(key string, check func()) {
+	if c.cache == nil {
+		check()
+		return
+	}
+
+	issues := []issue{}
+	c.current.issues = &issues
+	check()
+	c.current.issues = nil
+	if err := c.cache.store(key, issues); err != nil {
+		c.logger.Warn("cache store failed", "key", key, "err", err)
+	}
+}
+
+func (c *checker) replay(issues []issue) {
+	for _, iss := range issues {
+		c.report(iss)
+	}
+}
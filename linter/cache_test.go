@@ -0,0 +1,54 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestCacheOtherFileDecls(t *testing.T) {
+	dir := t.TempDir()
+	cacheDir := t.TempDir()
+	writeFile := func(name, src string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func() []string {
+		t.Helper()
+		l := &linter{
+			fset:                       token.NewFileSet(),
+			maxFirstParagraphSentences: 3,
+			logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+			cache:                      &resultCache{dir: cacheDir},
+		}
+		if err := l.applyConfig(defaultConfig(), nil); err != nil {
+			t.Fatal(err)
+		}
+		var checks []string
+		l.sink = func(iss issue, sev severity) {
+			checks = append(checks, filepath.Base(iss.Pos.Filename)+":"+iss.Check)
+		}
+		l.checkDir(dir)
+		return checks
+	}
+
+	writeFile("a.go", "// Package p is a test.\npackage p\n\n// Procesx processes the data.\nfunc Process() {}\n")
+	writeFile("b.go", "package p\n\n// Procesx processes the data too.\nfunc Procesx() {}\n")
+	if checks := check(); slices.Contains(checks, "a.go:name-typo") {
+		t.Fatalf("name-typo is reported for the declared name Procesx: %v", checks)
+	}
+	if checks := check(); slices.Contains(checks, "a.go:name-typo") {
+		t.Fatalf("name-typo is reported for the declared name Procesx with the cache: %v", checks)
+	}
+
+	writeFile("b.go", "package p\n")
+	if checks := check(); !slices.Contains(checks, "a.go:name-typo") {
+		t.Errorf("name-typo is not reported after Procesx is removed from b.go: %v", checks)
+	}
+}
@@ -0,0 +1,128 @@
+package linter
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// checkSentenceCase reports the sentences of the doc prose that start
+// with a lower case letter, usually the ones appended to the doc later,
+// like "Parse parses the config. it fails on the empty input."
+//
+// The sentences are split by the tokenizer, so the abbreviations like
+// "e.g." don't end them. The first sentence starts with the documented
+// name and is not checked, neither are the first sentences of the
+// paragraphs after a list or a code block, which can continue the
+// sentence before it, and the sentences that start with an identifier,
+// a number or the punctuation. Code blocks, lists, headings and link
+// definitions end the paragraphs.
+func (c *checker) checkSentenceCase(d declDoc) {
+	used := make(map[string]bool)
+	if d.node != nil {
+		ast.Inspect(d.node, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+			return true
+		})
+	}
+
+	// continued is set for the paragraphs that can continue the sentence
+	// of the previous block: the first one and the ones after a list
+	// or a code block, like "or in terms of the ...".
+	continued := true
+	var paragraph []*ast.Comment
+	flush := func() {
+		if len(paragraph) != 0 {
+			c.checkParagraphCase(paragraph, continued, used)
+			continued = false
+		}
+		paragraph = nil
+	}
+	for _, comment := range d.doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			return
+		}
+		text := strings.TrimPrefix(comment.Text[len("//"):], " ")
+		if isIndented(text) || listMarkerRegexp.MatchString(text) {
+			flush()
+			continued = true
+			continue
+		}
+		if isDirective(comment.Text) || strings.TrimSpace(text) == "" || !isProseLine(text) {
+			flush()
+			continue
+		}
+		paragraph = append(paragraph, comment)
+	}
+	flush()
+}
+
+// checkParagraphCase checks the sentences of the paragraph lines,
+// the first one is skipped for the continued paragraphs.
+func (c *checker) checkParagraphCase(paragraph []*ast.Comment, continued bool, used map[string]bool) {
+	var sb strings.Builder
+	starts := make([]int, len(paragraph))
+	for i, comment := range paragraph {
+		if i != 0 {
+			sb.WriteByte('\n')
+		}
+		starts[i] = sb.Len()
+		sb.WriteString(strings.TrimPrefix(comment.Text[len("//"):], " "))
+	}
+	sentences := c.tokenizer.Sentences(sb.String())
+	for i, s := range sentences {
+		if i == 0 && continued {
+			continue
+		}
+		if i != 0 && endsWithQuotedPunct(sentences[i-1].Text) {
+			// The quoted punctuation, like '.' or '?', doesn't end the sentence.
+			continue
+		}
+		word, ok := lowerCaseWord(s.Text)
+		if !ok || used[word] || c.declared[word] || c.words.Has(word) || lowerCaseNames[word] || types.Universe.Lookup(word) != nil {
+			continue
+		}
+		line := 0
+		for line+1 < len(starts) && starts[line+1] <= s.Start {
+			line++
+		}
+		c.warnComment("sentence-case", paragraph[line], "sentence starts with %q, capitalize it", word)
+	}
+}
+
+// lowerCaseNames are the names that are spelled in the lower
+// case at the sentence start too, in addition to the builtins.
+var lowerCaseNames = map[string]bool{
+	"go":        true,
+	"gofmt":     true,
+	"goimports": true,
+	"gopls":     true,
+}
+
+// endsWithQuotedPunct reports whether the text ends with
+// a quoted punctuation character, like '?'.
+func endsWithQuotedPunct(text string) bool {
+	n := len(text)
+	return n >= 3 && text[n-1] == text[n-3] && (text[n-1] == '\'' || text[n-1] == '"')
+}
+
+// lowerCaseWord returns the first word of the sentence if it's a lower
+// case word. The identifiers, like os.Open, go/doc or f(x), are not.
+func lowerCaseWord(text string) (string, bool) {
+	r, _ := utf8.DecodeRuneInString(text)
+	if !unicode.IsLower(r) {
+		return "", false
+	}
+	word := proseWordsRegexp.FindString(text)
+	if !strings.HasPrefix(text, word) || !isProseWord(word) {
+		return "", false
+	}
+	if next, _ := utf8.DecodeRuneInString(text[len(word):]); next == '.' || next == '/' || next == '(' || next == '[' {
+		return "", false
+	}
+	return word, true
+}
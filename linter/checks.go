@@ -0,0 +1,296 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// severity tells how important the check findings are.
+type severity int
+
+const (
+	severityInfo severity = iota
+	severityWarning
+	severityError
+)
+
+var severityNames = [...]string{
+	severityInfo:    "info",
+	severityWarning: "warning",
+	severityError:   "error",
+}
+
+func (s severity) String() string { return severityNames[s] }
+
+func parseSeverity(s string) (severity, error) {
+	for i, name := range severityNames {
+		if name == s {
+			return severity(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown severity %q (expected one of %s)", s, strings.Join(severityNames[:], ", "))
+}
+
+// checkInfo describes a single check.
+type checkInfo struct {
+	// id is a stable check identifier, like DC001.
+	// IDs are never reused, new checks get the next free one.
+	id string
+
+	name string
+
+	// severity is a default check severity.
+	severity severity
+
+	// description is a short explanation of what the check enforces.
+	description string
+}
+
+var checkList = []*checkInfo{
+	{id: "DC001", name: "parse", severity: severityError,
+		description: "The file must be parsable Go code."},
+	{id: "DC002", name: "package-doc", severity: severityError,
+		description: "The package must have exactly one doc-comment."},
+	{id: "DC003", name: "doc-go", severity: severityWarning,
+		description: "Long package doc-comments belong to the doc.go file."},
+	{id: "DC004", name: "package-prefix", severity: severityWarning,
+		description: "The package doc-comment starts with \"Package <name>\"."},
+	{id: "DC005", name: "package-synopsis", severity: severityWarning,
+		description: "The package synopsis is a short sentence that ends with a period."},
+	{id: "DC006", name: "command-doc", severity: severityWarning,
+		description: "The command doc-comment starts with the command name or \"Command\"."},
+	{id: "DC007", name: "command-usage", severity: severityInfo,
+		description: "The command doc-comment describes the command usage."},
+	{id: "DC008", name: "predicate", severity: severityWarning,
+		description: "The bool function doc-comment is in the \"<name> reports whether\" form."},
+	{id: "DC009", name: "this-opening", severity: severityWarning,
+		description: "The doc-comment starts with the documented name instead of \"This function\"."},
+	{id: "DC010", name: "name-typo", severity: severityWarning,
+		description: "The doc-comment doesn't start with a misspelled or differently cased name."},
+	{id: "DC011", name: "method-doc", severity: severityWarning,
+		description: "The method doc-comment starts with the method name, not the receiver type."},
+	{id: "DC012", name: "method-docs", severity: severityInfo,
+		description: "The exported methods of the exported types are documented."},
+	{id: "DC013", name: "type-alias", severity: severityWarning,
+		description: "The exported type aliases have their own doc-comments."},
+	{id: "DC014", name: "enum-doc", severity: severityWarning,
+		description: "The iota constants of the exported types are documented and mention their type."},
+	{id: "DC015", name: "type-param-doc", severity: severityWarning,
+		description: "The docs of the generic declarations mention their type parameters."},
+	{id: "DC016", name: "receiver-name", severity: severityInfo,
+		description: "The method doc-comment refers to the receiver by its name."},
+	{id: "DC017", name: "constructor-doc", severity: severityWarning,
+		description: "The NewX doc-comment is in the \"NewX returns a new X\" form."},
+	{id: "DC018", name: "panic-doc", severity: severityWarning,
+		description: "The doc-comment of a panicking function describes when it panics."},
+	{id: "DC019", name: "param-ref", severity: severityWarning,
+		description: "The names referred to as parameters in the doc-comment are the function parameters."},
+	{id: "DC020", name: "accessor-doc", severity: severityWarning,
+		description: "The getter and setter doc-comments are in the \"X returns\" and \"SetX sets\" forms."},
+	{id: "DC021", name: "no-multiline", severity: severityWarning,
+		description: "Doc-comments use // comments instead of /**/ comments."},
+	{id: "DC022", name: "ends-with-punct", severity: severityWarning,
+		description: "The doc-comment ends with punctuation."},
+	{id: "DC023", name: "spacing", severity: severityWarning,
+		description: "The comment text is separated from // with a space."},
+	{id: "DC024", name: "trailing-space", severity: severityWarning,
+		description: "Doc-comment lines have no trailing whitespace."},
+	{id: "DC025", name: "trailing-empty-line", severity: severityWarning,
+		description: "Doc-comments don't end with an empty line."},
+	{id: "DC026", name: "typography", severity: severityWarning,
+		description: "Doc-comments use ASCII quotes, dashes and spaces."},
+	{id: "DC027", name: "first-paragraph", severity: severityInfo,
+		description: "The first doc-comment paragraph is a short synopsis."},
+	{id: "DC028", name: "code-block", severity: severityWarning,
+		description: "Code blocks are indented consistently and render as code."},
+	{id: "DC029", name: "url", severity: severityWarning,
+		description: "URLs are auto-linked by pkg.go.dev."},
+	{id: "DC030", name: "deprecated", severity: severityWarning,
+		description: "Deprecation notices are trailing \"Deprecated: \" paragraphs."},
+	{id: "DC031", name: "bug-note", severity: severityWarning,
+		description: "BUG notes are separate \"BUG(owner): text\" comments."},
+	{id: "DC032", name: "interface-doc-dup", severity: severityWarning,
+		description: "The method doc-comment doesn't repeat the implemented interface method doc."},
+	{id: "DC033", name: "directive-typo", severity: severityWarning,
+		description: "The directive is spelled correctly."},
+	{id: "DC034", name: "directive-placement", severity: severityWarning,
+		description: "Directives follow the doc-comment text."},
+	{id: "DC035", name: "directive-format", severity: severityWarning,
+		description: "Directives have no space after //, and prose comments have one."},
+	{id: "DC036", name: "identifier-style", severity: severityWarning,
+		description: "Package identifiers are mentioned in the configured style."},
+	{id: "DC037", name: "duplicate-doc", severity: severityWarning,
+		description: "The doc-comment is not a copy of another declaration doc."},
+	{id: "DC038", name: "doc-tag", severity: severityWarning,
+		description: "The doc-comment has no Javadoc or Doxygen tags like @param."},
+	{id: "DC039", name: "markdown", severity: severityWarning,
+		description: "The doc-comment has no Markdown syntax that go/doc renders verbatim."},
+	{id: "DC040", name: "initialism", severity: severityWarning,
+		description: "Initialisms like URL are not spelled in the mixed case, and the identifiers are mentioned as declared."},
+	{id: "DC041", name: "filler-word", severity: severityWarning,
+		description: "The doc-comment has no filler words like \"simply\" or \"obviously\"."},
+	{id: "DC042", name: "spelling", severity: severityWarning,
+		description: "The package docs use the same American or British spelling variants."},
+	{id: "DC043", name: "wrap", severity: severityInfo,
+		description: "The doc-comment prose lines fit the configured width."},
+	{id: "DC044", name: "deprecated-replacement", severity: severityWarning,
+		description: "Deprecation notices tell what to use instead or that there is no replacement."},
+	{id: "DC045", name: "error-doc", severity: severityWarning,
+		description: "The doc-comment of a function returning an error describes when it fails."},
+	{id: "DC046", name: "error-value-doc", severity: severityWarning,
+		description: "The exported sentinel errors and error types are documented as \"ErrX is returned when ...\"."},
+	{id: "DC047", name: "import-comment", severity: severityError,
+		description: "The canonical import comment follows the package clause and has the package import path."},
+	{id: "DC048", name: "license-header", severity: severityWarning,
+		description: "The license header is separated from the package doc and the package clause by an empty line."},
+	{id: "DC049", name: "build-constraint", severity: severityError,
+		description: "Build constraints precede the package doc and are followed by an empty line."},
+	{id: "DC050", name: "double-space", severity: severityWarning,
+		description: "The doc-comment prose has no double spaces between the words."},
+	{id: "DC051", name: "repeated-word", severity: severityWarning,
+		description: "The doc-comment prose has no words repeated one after another, like \"the the\"."},
+	{id: "DC052", name: "sentence-case", severity: severityWarning,
+		description: "The doc-comment sentences start with an upper case letter or an identifier."},
+}
+
+// initSeverities fills s.severities with the default check severities
+// and then applies the overrides.
+//
+// Every override is a "check=severity" pair.
+func (s *settings) initSeverities(overrides []string) error {
+	s.severities = make(map[string]severity, len(checkList))
+	for _, info := range checkList {
+		s.severities[info.name] = info.severity
+	}
+	for _, rule := range s.customRules {
+		s.severities[rule.name] = rule.severity
+	}
+	for _, check := range s.checks {
+		s.severities[check.Name()] = severityWarning
+	}
+
+	for _, o := range overrides {
+		name, value, ok := strings.Cut(o, "=")
+		if !ok {
+			return fmt.Errorf("%q: expected check=severity", o)
+		}
+		if info := findCheck(name); info != nil {
+			name = info.name
+		}
+		if _, ok := s.severities[name]; !ok {
+			return fmt.Errorf("%q: unknown check %q (expected one of %s)", o, name, strings.Join(s.checkNames(), ", "))
+		}
+		sev, err := parseSeverity(value)
+		if err != nil {
+			return fmt.Errorf("%q: %v", o, err)
+		}
+		s.severities[name] = sev
+	}
+
+	return nil
+}
+
+// findCheck returns the check by its name or ID,
+// or nil if there is no such check.
+func findCheck(check string) *checkInfo {
+	for _, info := range checkList {
+		if info.name == check || info.id == check {
+			return info
+		}
+	}
+	return nil
+}
+
+// checkLabel returns the check name prefixed with its ID.
+// Custom rules have no IDs and are labeled by their names.
+func checkLabel(name string) string {
+	if info := findCheck(name); info != nil {
+		return info.id + " " + info.name
+	}
+	return name
+}
+
+// checkEnabled reports whether the check is enabled by the config.
+// Most of the checks are always enabled, only their severities vary.
+func (s *settings) checkEnabled(name string) bool {
+	switch name {
+	case "command-usage":
+		return s.commandUsage
+	case "receiver-name":
+		return s.receiverName
+	case "accessor-doc":
+		return s.accessorDocs
+	case "error-doc":
+		return s.errorDocs
+	case "type-param-doc":
+		return s.typeParamDocs > 0
+	case "identifier-style":
+		return s.identStyle != ""
+	case "duplicate-doc":
+		return s.duplicateDocSimilarity > 0
+	case "wrap":
+		return s.wrapWidth > 0
+	case "spelling":
+		return s.spelling != ""
+	case "filler-word":
+		return s.fillerWords != nil
+	}
+	return true
+}
+
+// printChecks writes the table of all checks, including
+// the custom rules and the registered checks, with the configured severities.
+func (s *settings) printChecks(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\tNAME\tSEVERITY\tSTATUS\tDESCRIPTION\n")
+	status := func(enabled bool) string {
+		if enabled {
+			return "enabled"
+		}
+		return "disabled"
+	}
+	for _, info := range checkList {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", info.id, info.name, s.severities[info.name],
+			status(s.checkEnabled(info.name)), s.catalog.description(info))
+	}
+	for _, rule := range s.customRules {
+		fmt.Fprintf(tw, "-\t%s\t%s\t%s\t%s\n", rule.name, s.severities[rule.name],
+			status(true), s.checkDescription(rule.name))
+	}
+	for _, check := range s.checks {
+		fmt.Fprintf(tw, "-\t%s\t%s\t%s\t%s\n", check.Name(), s.severities[check.Name()],
+			status(true), check.Description())
+	}
+	return tw.Flush()
+}
+
+// checkDescription returns the check description.
+func (s *settings) checkDescription(name string) string {
+	for _, info := range checkList {
+		if info.name == name {
+			return s.catalog.description(info)
+		}
+	}
+	for _, rule := range s.customRules {
+		if rule.name == name {
+			return s.catalog.sprintf("User-defined rule %q.", rule.re)
+		}
+	}
+	if check := findRegistered(s.checks, name); check != nil {
+		return check.Description()
+	}
+	return ""
+}
+
+// checkNames returns the names of all builtin, custom and registered checks.
+func (s *settings) checkNames() []string {
+	names := make([]string, 0, len(s.severities))
+	for name := range s.severities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
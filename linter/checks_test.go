@@ -0,0 +1,31 @@
+package linter_test
+
+import (
+	"testing"
+
+	"github.com/Quasilyte/doccheck/linter"
+	"github.com/Quasilyte/doccheck/linter/linttest"
+)
+
+// checksConfig enables the optional checks for the testdata/selftest fixtures.
+const checksConfig = `{
+	"command_usage": true,
+	"receiver_name": true,
+	"accessor_docs": true,
+	"error_docs": true,
+	"type_param_docs": 1,
+	"parse_code_blocks": true,
+	"identifier_style": "consistent",
+	"spelling": "consistent",
+	"filler": {"enabled": true},
+	"wrap_width": 100,
+	"duplicate_doc_similarity": 0.9
+}`
+
+func TestChecks(t *testing.T) {
+	conf, err := linter.ParseConfig([]byte(checksConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	linttest.Run(t, "testdata/selftest", linter.Options{Config: conf})
+}
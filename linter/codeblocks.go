@@ -0,0 +1,132 @@
+package linter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// listMarkerRegexp matches the go/doc list item markers.
+var listMarkerRegexp = regexp.MustCompile(`^\s*([-*+•]|\d+[.)])\s`)
+
+// docLine is a line comment text without the comment marker
+// and the first space, like the go/doc sees it.
+type docLine struct {
+	text    string
+	comment *ast.Comment
+}
+
+// checkCodeBlocks checks the code blocks of the doc-comment render
+// as code: they are indented consistently, are not written as
+// Markdown fences and don't merge into the surrounding prose.
+// With parse_code_blocks, the blocks that look like Go code
+// are also checked to be parsable.
+func (c *checker) checkCodeBlocks(doc *ast.CommentGroup) {
+	var lines []docLine
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			// go/doc doesn't recognize code blocks in /**/ comments
+			// the same way, they're reported by no-multiline anyway.
+			return
+		}
+		if isDirective(comment.Text) {
+			continue
+		}
+		text := strings.TrimPrefix(comment.Text[len("//"):], " ")
+		lines = append(lines, docLine{text: text, comment: comment})
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(strings.TrimSpace(line.text), "```"):
+			c.warnComment("code-block", line.comment, "fenced code blocks are not supported by go/doc, indent the code instead")
+			return
+		case isIndented(line.text):
+			end := i + 1
+			for end < len(lines) && (isIndented(lines[end].text) || strings.TrimSpace(lines[end].text) == "") {
+				end++
+			}
+			if !listMarkerRegexp.MatchString(line.text) {
+				c.checkCodeBlock(lines[i:end])
+			}
+			i = end - 1
+		case looksLikeCode(line.text):
+			c.warnComment("code-block", line.comment, "line looks like code, indent it to render as a code block")
+		}
+	}
+}
+
+func (c *checker) checkCodeBlock(block []docLine) {
+	var indent string
+	var code []string
+	for _, line := range block {
+		if strings.TrimSpace(line.text) == "" {
+			code = append(code, "")
+			continue
+		}
+		lineIndent := line.text[:len(line.text)-len(strings.TrimLeft(line.text, " \t"))]
+		if indent == "" {
+			indent = lineIndent
+		} else if lineIndent[0] != indent[0] {
+			c.warnComment("code-block", line.comment, "code block mixes tab and space indentation")
+			return
+		}
+		code = append(code, line.text)
+	}
+
+	if !c.parseCodeBlocks {
+		return
+	}
+	text := strings.Join(code, "\n")
+	if looksLikeGo(text) && !isParsableGo(text) {
+		c.warnComment("code-block", block[0].comment, "code block looks like Go code, but it can't be parsed")
+	}
+}
+
+func isIndented(text string) bool {
+	return strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")
+}
+
+// looksLikeCode reports whether the prose line is likely an unindented
+// code line, which go/doc would merge into the paragraph text.
+func looksLikeCode(text string) bool {
+	text = strings.TrimSpace(text)
+	return text == "}" || text == "})" ||
+		strings.HasSuffix(text, ") {") ||
+		strings.Contains(text, " := ") && !strings.HasSuffix(text, ".")
+}
+
+// looksLikeGo reports whether the code block is likely a Go snippet
+// rather than a shell session, program output or some grammar.
+func looksLikeGo(code string) bool {
+	first := strings.TrimSpace(code)
+	if strings.HasPrefix(first, "$ ") || strings.HasPrefix(first, "> ") {
+		return false
+	}
+	for _, marker := range []string{":= ", "func ", "package ", "import ", "return ", "if err != nil"} {
+		if strings.Contains(code, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// isParsableGo reports whether code is a valid Go file,
+// top-level declarations, statements list or an expression.
+func isParsableGo(code string) bool {
+	fset := token.NewFileSet()
+	if _, err := parser.ParseFile(fset, "", code, parser.ParseComments); err == nil {
+		return true
+	}
+	if _, err := parser.ParseFile(fset, "", "package p\n"+code, parser.ParseComments); err == nil {
+		return true
+	}
+	if _, err := parser.ParseFile(fset, "", "package p\nfunc _() {\n"+code+"\n}", parser.ParseComments); err == nil {
+		return true
+	}
+	_, err := parser.ParseExpr(code)
+	return err == nil
+}
@@ -0,0 +1,71 @@
+package linter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// codeClimateIssue is a -format=codeclimate report entry, as in the Code
+// Climate spec subset that the GitLab Code Quality widget understands.
+type codeClimateIssue struct {
+	Type        string              `json:"type"`
+	CheckName   string              `json:"check_name"`
+	Description string              `json:"description"`
+	Categories  []string            `json:"categories"`
+	Severity    string              `json:"severity"`
+	Fingerprint string              `json:"fingerprint"`
+	Location    codeClimateLocation `json:"location"`
+}
+
+type codeClimateLocation struct {
+	Path  string           `json:"path"`
+	Lines codeClimateLines `json:"lines"`
+}
+
+type codeClimateLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end"`
+}
+
+// codeClimateSeverities maps the severities to the Code Climate ones.
+var codeClimateSeverities = [...]string{
+	severityInfo:    "info",
+	severityWarning: "minor",
+	severityError:   "major",
+}
+
+// writeCodeClimateReport writes the collected issues as a Code Climate
+// JSON array, the GitLab Code Quality report artifact.
+//
+// The fingerprints don't depend on the line numbers, so the findings
+// are not reported as new when the code above them changes.
+// The same findings of the file are told apart by their number.
+func writeCodeClimateReport(w io.Writer, c *issueCollector) error {
+	report := make([]codeClimateIssue, 0, len(c.diagnostics))
+	seen := make(map[string]int)
+	for _, d := range c.diagnostics {
+		path := filepath.ToSlash(d.Pos.Filename)
+		key := fmt.Sprintf("%s\x00%s\x00%s\x00%s", d.Check, path, d.Name, d.Message)
+		seen[key]++
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d", key, seen[key])))
+
+		lines := codeClimateLines{Begin: max(d.Pos.Line, 1), End: max(d.End.Line, d.Pos.Line, 1)}
+		report = append(report, codeClimateIssue{
+			Type:        "issue",
+			CheckName:   checkLabel(d.Check),
+			Description: d.Message,
+			Categories:  []string{"Style"},
+			Severity:    codeClimateSeverities[d.severity()],
+			Fingerprint: hex.EncodeToString(sum[:]),
+			Location:    codeClimateLocation{Path: path, Lines: lines},
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
@@ -0,0 +1,146 @@
+package linter
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// codeOwners is a parsed CODEOWNERS file.
+type codeOwners struct {
+	// root is a directory the patterns are relative to.
+	root string
+
+	rules []ownersRule
+}
+
+type ownersRule struct {
+	pattern *regexp.Regexp
+	owners  []string
+}
+
+// findCodeOwners looks up the CODEOWNERS file starting from dir and
+// going up to the filesystem root. Locations recognized by GitHub
+// and GitLab are checked in every directory.
+func findCodeOwners(dir string) (*codeOwners, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		for _, name := range []string{"CODEOWNERS", ".github/CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"} {
+			filename := filepath.Join(dir, filepath.FromSlash(name))
+			if _, err := os.Stat(filename); err == nil {
+				return parseCodeOwners(dir, filename)
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, os.ErrNotExist
+		}
+		dir = parent
+	}
+}
+
+func parseCodeOwners(root, filename string) (*codeOwners, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	co := &codeOwners{root: root}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		var owners []string
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			owners = append(owners, owner)
+		}
+		co.rules = append(co.rules, ownersRule{
+			pattern: compileOwnersPattern(fields[0]),
+			owners:  owners,
+		})
+	}
+	return co, s.Err()
+}
+
+// compileOwnersPattern converts gitignore-style pattern to a regexp.
+//
+// Patterns that end with a slash or have no wildcards in the last
+// segment match a directory and everything inside it, so "docs/*"
+// only matches the direct children of docs, as in GitHub.
+// Patterns without a non-trailing slash match at any depth.
+func compileOwnersPattern(pat string) *regexp.Regexp {
+	last := pat[strings.LastIndexByte(pat, '/')+1:]
+	subtree := last == "" || !strings.ContainsAny(last, "*?")
+	pat = strings.TrimSuffix(pat, "/")
+	anchored := strings.Contains(pat, "/")
+	pat = strings.TrimPrefix(pat, "/")
+
+	var buf strings.Builder
+	buf.WriteString("^")
+	if !anchored {
+		buf.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pat); i++ {
+		switch {
+		case strings.HasPrefix(pat[i:], "**/"):
+			buf.WriteString("(?:.*/)?")
+			i += len("**/") - 1
+		case strings.HasPrefix(pat[i:], "**"):
+			buf.WriteString(".*")
+			i++
+		case pat[i] == '*':
+			buf.WriteString("[^/]*")
+		case pat[i] == '?':
+			buf.WriteString("[^/]")
+		default:
+			buf.WriteString(regexp.QuoteMeta(pat[i : i+1]))
+		}
+	}
+	if subtree {
+		buf.WriteString("(?:/.*)?")
+	}
+	buf.WriteString("$")
+	return regexp.MustCompile(buf.String())
+}
+
+// Owners returns the owners of the given file.
+// As in git, the last matching rule takes precedence.
+func (co *codeOwners) Owners(filename string) []string {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return nil
+	}
+	rel, err := filepath.Rel(co.root, abs)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return nil
+	}
+	rel = filepath.ToSlash(rel)
+	for i := len(co.rules) - 1; i >= 0; i-- {
+		if co.rules[i].pattern.MatchString(rel) {
+			return co.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// IsOwnedBy reports whether filename is owned by the given owner.
+func (co *codeOwners) IsOwnedBy(filename, owner string) bool {
+	for _, o := range co.Owners(filename) {
+		if strings.EqualFold(o, owner) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package linter
+
+import "testing"
+
+func TestCompileOwnersPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"docs/*", "docs/a.go", true},
+		{"docs/*", "docs/a/b.go", false},
+		{"docs/*", "x/docs/a.go", false},
+		{"docs/", "docs/a/b.go", true},
+		{"docs", "docs/a/b.go", true},
+		{"docs", "x/docs/a.go", true},
+		{"/docs", "x/docs/a.go", false},
+		{"/build/logs/", "build/logs/a/b.log", true},
+		{"apps/**", "apps/a/b/c.go", true},
+		{"**/mocks/**", "a/mocks/b/c.go", true},
+		{"**/mocks/**", "mocks/c.go", true},
+		{"*.go", "a/b/c.go", true},
+		{"*.go", "a/b/c.gox", false},
+		{"zz_generated*.go", "pkg/zz_generated.deepcopy.go", true},
+		{"a/?.go", "a/b.go", true},
+		{"a/?.go", "a/bc.go", false},
+		{"a/b.go", "a/b.go", true},
+		{"a/b.go", "a/b.gox", false},
+	}
+	for _, test := range tests {
+		re := compileOwnersPattern(test.pattern)
+		if have := re.MatchString(test.path); have != test.want {
+			t.Errorf("%q matches %q: have %v, want %v (regexp %s)", test.pattern, test.path, have, test.want, re)
+		}
+	}
+}
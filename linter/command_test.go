@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCommandDocModuleRoot(t *testing.T) {
+	tests := []struct {
+		modPath string
+		doc     string
+		want    bool
+	}{
+		{"example.com/tool", "Tool checks things.", false},
+		{"example.com/tool", "Command tool checks things.", false},
+		{"example.com/tool/v2", "Tool checks things.", false},
+		{"example.com/tool/v2", "V2 checks things.", true},
+		{"tool", "Tool checks things.", false},
+		{"example.com/tool", "Module checks things.", true},
+	}
+	for _, test := range tests {
+		dir := filepath.Join(t.TempDir(), "module")
+		files := map[string]string{
+			"go.mod":  "module " + test.modPath + "\n",
+			"main.go": "// " + test.doc + "\npackage main\n\nfunc main() {}\n",
+		}
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		for name, src := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		l := &linter{
+			fset:                       token.NewFileSet(),
+			maxFirstParagraphSentences: 3,
+			logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		}
+		if err := l.applyConfig(defaultConfig(), nil); err != nil {
+			t.Fatal(err)
+		}
+		var reported []string
+		l.sink = func(iss issue, sev severity) {
+			if iss.Check == "command-doc" {
+				reported = append(reported, iss.Message)
+			}
+		}
+		l.checkDir(dir)
+		if have := len(reported) != 0; have != test.want {
+			t.Errorf("module %s, %q: command-doc reported %v, want %v: %q", test.modPath, test.doc, have, test.want, reported)
+		}
+	}
+}
@@ -0,0 +1,550 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// defaultConfigFile is looked up in the checked directories and
+// their parents when -config is not specified, see dirSettings.
+const defaultConfigFile = ".doccheck.json"
+
+// Config is a doccheck configuration file contents.
+type Config struct {
+	// Rules are user-defined checks.
+	Rules []ruleConfig `json:"rules"`
+
+	// Abbreviations extend the list of words like "e.g." that
+	// don't end a sentence.
+	Abbreviations []string `json:"abbreviations"`
+
+	// Initialisms extend the defaultInitialisms list of the words
+	// like "URL" that are never spelled in the mixed case.
+	Initialisms []string `json:"initialisms"`
+
+	// IdentifierStyle enables the identifier-style check.
+	// It's one of "plain", "quoted", "link" or "consistent".
+	IdentifierStyle string `json:"identifier_style"`
+
+	// Spelling enables the spelling check. It's one of "american",
+	// "british" or "consistent" to use the variants that the package
+	// docs use the most.
+	Spelling string `json:"spelling"`
+
+	// CommandUsage enables the check that main package docs describe the usage.
+	CommandUsage bool `json:"command_usage"`
+
+	// ReceiverName enables the check that method docs refer
+	// to the receiver by its name instead of "this" or "self".
+	ReceiverName bool `json:"receiver_name"`
+
+	// AccessorDocs enables the getter and setter doc-comment check.
+	AccessorDocs bool `json:"accessor_docs"`
+
+	// ErrorDocs enables the check that the docs of the functions
+	// returning an error describe when they fail.
+	ErrorDocs bool `json:"error_docs"`
+
+	// TypeParamDocs is a min number of type parameters for
+	// the generic declaration docs to be checked to mention
+	// all of them. 0 disables the check.
+	TypeParamDocs int `json:"type_param_docs"`
+
+	// ParseCodeBlocks enables the check that doc-comment code blocks
+	// that look like Go code are syntactically valid.
+	ParseCodeBlocks bool `json:"parse_code_blocks"`
+
+	DocGo docGoConfig `json:"doc_go"`
+
+	Predicate predicateConfig `json:"predicate"`
+
+	Filler fillerConfig `json:"filler"`
+
+	// WrapWidth is a max doc-comment line length, counting from
+	// the // marker, for the wrap check. 0 disables the check.
+	WrapWidth int `json:"wrap_width"`
+
+	// MaxSynopsisLength is a max package synopsis length in bytes.
+	// Longer synopses are truncated in the package lists.
+	MaxSynopsisLength int `json:"max_synopsis_length"`
+
+	// DuplicateDocSimilarity is a min similarity ratio in (0, 1]
+	// for two doc-comments to be reported as duplicates.
+	// 1 means that only identical docs are reported, 0 disables the check.
+	DuplicateDocSimilarity float64 `json:"duplicate_doc_similarity"`
+
+	// Exclude are the glob patterns of the files and directories
+	// that are not checked, like "**/mocks/**". They extend -exclude.
+	// Only the config files of the current directory and its parents
+	// can set them, see mergeConfigs.
+	Exclude []string `json:"exclude"`
+
+	// Messages are the per-check message templates, like
+	// "{{.Message}}, see https://example.com/style#{{.Rule}}".
+	// See messageData for the available fields.
+	Messages map[string]string `json:"messages"`
+
+	// TestSeamSeverity is a max severity for the findings about
+	// exported symbols declared in the package _test.go files.
+	TestSeamSeverity string `json:"test_seam_severity"`
+
+	// SentenceSpacing is either "single" or "double" to accept two
+	// spaces after the sentence ends in the double-space check.
+	// Default is "single".
+	SentenceSpacing string `json:"sentence_spacing"`
+
+	// Language is the language of the messages, the check descriptions
+	// and the explain texts, like "ru". Default is English.
+	Language string `json:"language"`
+}
+
+// docGoConfig is a doc-go check policy.
+type docGoConfig struct {
+	// MaxLines is a max package doc-comment length
+	// that is permitted outside of the doc.go file.
+	MaxLines int `json:"max_lines"`
+
+	// CheckMain enables the check for main packages.
+	CheckMain bool `json:"check_main"`
+
+	// Strict requires the package doc-comment to be in doc.go
+	// whenever the package has that file, regardless of its length.
+	Strict bool `json:"strict"`
+}
+
+// predicateConfig is a predicate check configuration.
+type predicateConfig struct {
+	// Prefixes are the predicate function name prefixes, like "Is" in IsValid.
+	// Docs of such functions should start with "<name> reports whether".
+	Prefixes []string `json:"prefixes"`
+
+	// Phrases are the predicate doc phrases that are less idiomatic
+	// than "reports whether" when they follow the function name.
+	Phrases []string `json:"phrases"`
+
+	// Exempt are the method names or signatures like "Less(int, int) bool"
+	// that are not checked. They extend the defaultPredicateExempt list.
+	Exempt []string `json:"exempt"`
+}
+
+// fillerConfig is a filler-word check configuration.
+type fillerConfig struct {
+	// Enabled enables the check.
+	Enabled bool `json:"enabled"`
+
+	// Words are the reported words and phrases.
+	// They replace the defaultFillerWords list.
+	Words []string `json:"words"`
+}
+
+// defaultPredicateExempt lists the well-known interface methods
+// that are not expected to follow the predicate doc style.
+var defaultPredicateExempt = []string{
+	"Less(int, int) bool",                       // sort.Interface
+	"Is(error) bool",                            // errors.Is
+	"Match([]byte) bool",                        // regexp-like matchers
+	"MatchString(string) bool",                  // regexp-like matchers
+	"Timeout() bool",                            // net.Error
+	"Temporary() bool",                          // net.Error
+	"IsDir() bool",                              // fs.FileInfo and fs.DirEntry
+	"Enabled(context.Context, slog.Level) bool", // slog.Handler
+}
+
+// defaultConfig returns a config with all default values set.
+func defaultConfig() *Config {
+	return &Config{
+		DocGo: docGoConfig{MaxLines: 100},
+		Predicate: predicateConfig{
+			Prefixes: []string{"Has", "Is", "Contains", "Can"},
+			Phrases: []string{
+				"returns true if",
+				"returns false if",
+				"returns true iff",
+				"returns false iff",
+				"returns true for",
+				"returns false for",
+				"returns true when",
+				"returns false when",
+				"tells whether",
+				"tests whether",
+				"determines whether",
+				"indicates whether",
+			},
+		},
+		Filler:                 fillerConfig{Words: slices.Clone(defaultFillerWords)},
+		MaxSynopsisLength:      200,
+		DuplicateDocSimilarity: 0.9,
+		TestSeamSeverity:       "info",
+	}
+}
+
+type ruleConfig struct {
+	// Name is a check name, used in -severity and reports.
+	Name string `json:"name"`
+
+	// Pattern is a regexp that is matched against every comment line.
+	Pattern string `json:"pattern"`
+
+	// Scope is either "line" or "sentence". Default is "line".
+	// Sentence patterns are matched against every sentence of the
+	// comment text, with whitespace sequences replaced by a single space.
+	Scope string `json:"scope"`
+
+	// Target is one of "package", "func" or "any".
+	// Default is "any".
+	Target string `json:"target"`
+
+	// Message is reported for every match.
+	// It may refer to the pattern submatches as $1, ${name}, etc.
+	Message string `json:"message"`
+
+	// Severity is a default rule severity. Default is "warning".
+	Severity string `json:"severity"`
+}
+
+// ruleTarget tells which comments are checked by a custom rule.
+type ruleTarget int
+
+const (
+	targetAny ruleTarget = iota
+	targetPackage
+	targetFunc
+)
+
+// customRule is a compiled user-defined check.
+type customRule struct {
+	name     string
+	re       *regexp.Regexp
+	target   ruleTarget
+	sentence bool
+	message  string
+	severity severity
+}
+
+// loadConfig reads the config file. If required is false,
+// a missing file is not an error and results in an empty config.
+func loadConfig(filename string, required bool) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return defaultConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	conf, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return conf, nil
+}
+
+// ParseConfig parses the JSON config. The options
+// that are not set keep their default values.
+func ParseConfig(data []byte) (*Config, error) {
+	conf := defaultConfig()
+	if err := decodeConfig(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// decodeConfig sets the options of the JSON config to conf,
+// the other options are kept. The lists that the JSON sets replace
+// the conf ones as a whole, see resetLists.
+func decodeConfig(data []byte, conf *Config) error {
+	resetLists(data, reflect.ValueOf(conf).Elem())
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(conf)
+}
+
+// resetLists sets the slices of the struct v that the JSON object
+// sets to nil, including the ones of the nested objects. Otherwise
+// encoding/json decodes the array elements into the existing ones,
+// and a rule of a nested config keeps the fields that it doesn't set
+// from the outer rule.
+func resetLists(data []byte, v reflect.Value) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return // Reported by the decoding.
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "" {
+			continue
+		}
+		for key, raw := range fields {
+			// The keys are matched the same way as by encoding/json.
+			if !strings.EqualFold(key, name) {
+				continue
+			}
+			switch f := v.Field(i); f.Kind() {
+			case reflect.Slice:
+				f.SetZero()
+			case reflect.Struct:
+				resetLists(raw, f)
+			}
+		}
+	}
+}
+
+// settings are the config values that tune the checks. Unlike the
+// command line flags, they may vary between the package directories.
+type settings struct {
+	tokenizer sentenceTokenizer
+
+	docGo docGoConfig
+
+	maxSynopsisLength int
+
+	// wrapWidth is a wrap check line width, 0 disables the check.
+	wrapWidth int
+
+	// duplicateDocSimilarity is a duplicate-doc check threshold, 0 disables the check.
+	duplicateDocSimilarity float64
+
+	// commandUsage enables the command-usage check.
+	commandUsage bool
+
+	// identStyle is the identifier-style check mode, empty if disabled.
+	identStyle string
+
+	// spelling is the spelling check mode, empty if disabled.
+	spelling string
+
+	// sentenceSpacing is the double-space check mode, "single" or "double".
+	sentenceSpacing string
+
+	// customRules are user-defined checks from the config file.
+	customRules []*customRule
+
+	// checks are the third-party checks, see Register.
+	checks []Check
+
+	// severities maps check names to their severity.
+	severities map[string]severity
+
+	// testSeamSeverity is a max severity of the issue.TestSeam findings.
+	testSeamSeverity severity
+
+	// predicatePrefixes, predicatePhrases and predicateExempt configure the predicate check.
+	predicatePrefixes []string
+	predicatePhrases  []string
+	predicateExempt   []string
+
+	// receiverName enables the receiver-name check.
+	receiverName bool
+
+	// accessorDocs enables the accessor-doc check.
+	accessorDocs bool
+
+	// errorDocs enables the error-doc check.
+	errorDocs bool
+
+	// parseCodeBlocks enables the Go syntax check for doc code blocks.
+	parseCodeBlocks bool
+
+	// initialisms are the upper case initialisms of the initialism check.
+	initialisms map[string]bool
+
+	// fillerWords is nil unless the filler-word check is enabled.
+	fillerWords *regexp.Regexp
+
+	// typeParamDocs is a type-param-doc check threshold.
+	typeParamDocs int
+
+	// messages are the message templates by the check name.
+	messages map[string]*template.Template
+
+	// language is the configured language, catalog
+	// is its translation or nil for English.
+	language string
+	catalog  *catalog
+
+	regexp struct {
+		predAntipattern *regexp.Regexp
+		predPrefix      *regexp.Regexp
+		directiveLike   *regexp.Regexp
+		thisOpening     *regexp.Regexp
+	}
+}
+
+// applyConfig configures the linter checks. The severity
+// overrides are applied on top of the configured severities.
+func (l *linter) applyConfig(conf *Config, overrides []string) error {
+	s, err := newSettings(l.withLanguage(conf), overrides)
+	if err != nil {
+		return err
+	}
+	l.settings = s
+	l.severityOverrides = overrides
+	l.excludes = compileExcludes(conf.Exclude)
+	l.excludeRoot, err = filepath.Abs(".")
+	return err
+}
+
+// newSettings returns the settings of the config with
+// the severity overrides applied.
+func newSettings(conf *Config, overrides []string) (*settings, error) {
+	s := &settings{}
+	s.tokenizer = newProseTokenizer(conf.Abbreviations)
+	s.commandUsage = conf.CommandUsage
+	s.receiverName = conf.ReceiverName
+	s.accessorDocs = conf.AccessorDocs
+	s.errorDocs = conf.ErrorDocs
+	s.parseCodeBlocks = conf.ParseCodeBlocks
+	s.initialisms = newInitialisms(conf.Initialisms)
+	if conf.Filler.Enabled {
+		s.fillerWords = compileFillerWords(conf.Filler.Words)
+	}
+	s.typeParamDocs = conf.TypeParamDocs
+	s.maxSynopsisLength = conf.MaxSynopsisLength
+	s.wrapWidth = conf.WrapWidth
+	s.duplicateDocSimilarity = conf.DuplicateDocSimilarity
+	s.docGo = conf.DocGo
+	s.predicatePrefixes = conf.Predicate.Prefixes
+	s.predicatePhrases = conf.Predicate.Phrases
+	s.predicateExempt = append(defaultPredicateExempt, conf.Predicate.Exempt...)
+	switch conf.IdentifierStyle {
+	case "", "plain", "quoted", "link", "consistent":
+		s.identStyle = conf.IdentifierStyle
+	default:
+		return nil, fmt.Errorf("config: unknown identifier_style %q (expected plain, quoted, link or consistent)", conf.IdentifierStyle)
+	}
+	switch conf.Spelling {
+	case "", "american", "british", "consistent":
+		s.spelling = conf.Spelling
+	default:
+		return nil, fmt.Errorf("config: unknown spelling %q (expected american, british or consistent)", conf.Spelling)
+	}
+	switch conf.SentenceSpacing {
+	case "", "single":
+		s.sentenceSpacing = "single"
+	case "double":
+		s.sentenceSpacing = conf.SentenceSpacing
+	default:
+		return nil, fmt.Errorf("config: unknown sentence_spacing %q (expected single or double)", conf.SentenceSpacing)
+	}
+	s.checks = registeredChecks()
+
+	var err error
+	s.customRules, err = compileRules(conf.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("config: %v", err)
+	}
+	s.testSeamSeverity, err = parseSeverity(conf.TestSeamSeverity)
+	if err != nil {
+		return nil, fmt.Errorf("config: test_seam_severity: %v", err)
+	}
+	if err := s.initSeverities(overrides); err != nil {
+		return nil, fmt.Errorf("-severity: %v", err)
+	}
+	s.messages, err = s.compileMessages(conf.Messages)
+	if err != nil {
+		return nil, fmt.Errorf("config: %v", err)
+	}
+	s.language = conf.Language
+	s.catalog, err = loadCatalog(s.language)
+	if err != nil {
+		return nil, fmt.Errorf("config: %v", err)
+	}
+	s.initRegexps()
+	return s, nil
+}
+
+// compileRules validates the user-defined rules.
+func compileRules(rules []ruleConfig) ([]*customRule, error) {
+	builtin := make(map[string]bool)
+	for _, info := range checkList {
+		builtin[info.name] = true
+	}
+
+	compiled := make([]*customRule, 0, len(rules))
+	seen := make(map[string]bool)
+	for i, r := range rules {
+		switch {
+		case r.Name == "":
+			return nil, fmt.Errorf("rules[%d]: name can't be empty", i)
+		case builtin[r.Name]:
+			return nil, fmt.Errorf("rules[%d]: %q clashes with a builtin check name", i, r.Name)
+		case seen[r.Name]:
+			return nil, fmt.Errorf("rules[%d]: duplicated rule name %q", i, r.Name)
+		case r.Message == "":
+			return nil, fmt.Errorf("rules[%d]: message can't be empty", i)
+		}
+		seen[r.Name] = true
+
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rules[%d]: pattern: %v", i, err)
+		}
+		rule := &customRule{name: r.Name, re: re, message: r.Message, severity: severityWarning}
+		switch r.Target {
+		case "", "any":
+			rule.target = targetAny
+		case "package":
+			rule.target = targetPackage
+		case "func":
+			rule.target = targetFunc
+		default:
+			return nil, fmt.Errorf("rules[%d]: unknown target %q (expected package, func or any)", i, r.Target)
+		}
+		switch r.Scope {
+		case "", "line":
+		case "sentence":
+			rule.sentence = true
+		default:
+			return nil, fmt.Errorf("rules[%d]: unknown scope %q (expected line or sentence)", i, r.Scope)
+		}
+		if r.Severity != "" {
+			rule.severity, err = parseSeverity(r.Severity)
+			if err != nil {
+				return nil, fmt.Errorf("rules[%d]: %v", i, err)
+			}
+		}
+		compiled = append(compiled, rule)
+	}
+	return compiled, nil
+}
+
+// checkCustomRules runs the user-defined rules of the given target
+// over the doc comment.
+func (c *checker) checkCustomRules(target ruleTarget, doc *ast.CommentGroup) {
+	for _, rule := range c.customRules {
+		if rule.target != target {
+			continue
+		}
+		if rule.sentence {
+			for _, s := range c.tokenizer.Sentences(doc.Text()) {
+				text := normalizeSpace(s.Text)
+				m := rule.re.FindStringSubmatchIndex(text)
+				if m == nil {
+					continue
+				}
+				message := rule.re.ExpandString(nil, rule.message, text, m)
+				c.warnGroup(rule.name, doc, "%s", message)
+			}
+			continue
+		}
+		for _, comment := range doc.List {
+			m := rule.re.FindStringSubmatchIndex(comment.Text)
+			if m == nil {
+				continue
+			}
+			message := rule.re.ExpandString(nil, rule.message, comment.Text, m)
+			c.warnComment(rule.name, comment, "%s", message)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package linter
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseConfigKeepsDefaults(t *testing.T) {
+	want := slices.Clone(defaultFillerWords)
+	if _, err := ParseConfig([]byte(`{"filler": {"words": ["foo", "bar"]}}`)); err != nil {
+		t.Fatal(err)
+	}
+	conf, err := ParseConfig([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(conf.Filler.Words, want) {
+		t.Errorf("filler words after the second parse:\nhave %q\nwant %q", conf.Filler.Words, want)
+	}
+	if !slices.Equal(defaultFillerWords, want) {
+		t.Errorf("defaultFillerWords are modified:\nhave %q\nwant %q", defaultFillerWords, want)
+	}
+}
+
+func TestNestedConfigExclude(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		defaultConfigFile:                       `{"exclude": ["gen/**"]}`,
+		filepath.Join("sub", defaultConfigFile): `{"exclude": ["mocks/**"]}`,
+	}
+	for name, data := range files {
+		filename := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(dir)
+
+	l := &linter{
+		logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		nestedConfigs: true,
+	}
+	if _, err := l.dirSettings("."); err != nil {
+		t.Fatalf("the current directory config: %v", err)
+	}
+	if _, err := l.dirSettings("sub"); err == nil || !strings.Contains(err.Error(), "exclude") {
+		t.Errorf("the nested config with exclude: have %v, want the exclude error", err)
+	}
+}
+
+func TestNestedConfigLists(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		defaultConfigFile: `{
+			"rules": [{"name": "outer", "pattern": "foo", "target": "func", "severity": "error"}],
+			"exclude": ["gen/**", "mocks/**"],
+			"predicate": {"prefixes": ["Has", "Is"]}
+		}`,
+		filepath.Join("sub", defaultConfigFile): `{
+			"rules": [{"name": "inner", "pattern": "bar", "message": "inner bar"}],
+			"exclude": ["tmp/**"],
+			"predicate": {"prefixes": ["Can"]}
+		}`,
+	}
+	for name, data := range files {
+		filename := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(filepath.Join(dir, "sub"))
+
+	l := &linter{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	conf, err := l.dirConfig(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRules := []ruleConfig{{Name: "inner", Pattern: "bar", Message: "inner bar"}}
+	if !slices.Equal(conf.Rules, wantRules) {
+		t.Errorf("rules:\nhave %+v\nwant %+v", conf.Rules, wantRules)
+	}
+	if want := []string{"tmp/**"}; !slices.Equal(conf.Exclude, want) {
+		t.Errorf("exclude:\nhave %q\nwant %q", conf.Exclude, want)
+	}
+	if want := []string{"Can"}; !slices.Equal(conf.Predicate.Prefixes, want) {
+		t.Errorf("predicate prefixes:\nhave %q\nwant %q", conf.Predicate.Prefixes, want)
+	}
+	if len(conf.Predicate.Phrases) == 0 {
+		t.Errorf("predicate phrases are reset by the nested predicate object")
+	}
+}
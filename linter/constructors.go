@@ -0,0 +1,99 @@
+package linter
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// constructorVerbs are the verbs that are expected to follow
+// the constructor name, like in "NewReader returns a new Reader".
+var constructorVerbs = []string{
+	"allocates",
+	"builds",
+	"constructs",
+	"creates",
+	"initializes",
+	"makes",
+	"returns",
+}
+
+// checkConstructorDoc checks that the NewX constructor docs
+// are in the "NewX returns a new X" form and mention the
+// constructed type in the first sentence.
+func (c *checker) checkConstructorDoc(doc *ast.CommentGroup) {
+	fn := c.current.fn
+	name := fn.Name.Name
+	suffix, ok := strings.CutPrefix(name, "New")
+	if fn.Recv != nil || !ok || suffix != "" && !unicode.IsUpper(rune(suffix[0])) {
+		return
+	}
+	typeName := constructedType(fn)
+	if typeName == "" {
+		return
+	}
+
+	sentences := c.tokenizer.Sentences(doc.Text())
+	if len(sentences) == 0 {
+		return
+	}
+	synopsis := normalizeSpace(sentences[0].Text)
+
+	rest, ok := strings.CutPrefix(synopsis, name+" ")
+	if !ok {
+		// Reported by the other checks.
+		return
+	}
+	verb, _, _ := strings.Cut(rest, " ")
+	knownVerb := false
+	for _, v := range constructorVerbs {
+		knownVerb = knownVerb || verb == v
+	}
+	if !knownVerb {
+		c.warnFuncRange("constructor-doc", doc.List[0].Pos(), doc.List[0].End(), "constructor doc-comment should be in the %q form", name+" returns a new "+typeName)
+		return
+	}
+
+	if !slices.Contains(wordRegexp.FindAllString(rest, -1), typeName) {
+		c.warnFuncRange("constructor-doc", doc.List[0].Pos(), doc.List[0].End(), "constructor doc-comment should mention the constructed %s type", typeName)
+	}
+}
+
+// constructedType returns the name of the type that is created
+// by the constructor. It's the first result type if it's a named
+// type of the current package, e.g. "Reader" for "*Reader".
+// Predeclared types like int and the type parameters of the
+// constructor are not considered constructed.
+func constructedType(fn *ast.FuncDecl) string {
+	if fn.Type.Results == nil || len(fn.Type.Results.List) == 0 {
+		return ""
+	}
+	typ := fn.Type.Results.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	var name string
+	switch typ := typ.(type) {
+	case *ast.IndexExpr:
+		name = identName(typ.X)
+	case *ast.IndexListExpr:
+		name = identName(typ.X)
+	default:
+		name = identName(typ)
+	}
+	if slices.Contains(typeParamNames(fn.Type.TypeParams), name) {
+		return ""
+	}
+	return name
+}
+
+// identName returns the x name if it's an identifier
+// that is not predeclared, and an empty string otherwise.
+func identName(x ast.Expr) string {
+	if id, ok := x.(*ast.Ident); ok && types.Universe.Lookup(id.Name) == nil {
+		return id.Name
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package linter
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"sort"
+	"strings"
+)
+
+// docCoverage is a share of the documented exported identifiers,
+// collected for -coverage and -min-coverage.
+type docCoverage struct {
+	byPackage map[string]*coverageCount
+}
+
+type coverageCount struct {
+	documented int
+	total      int
+}
+
+func (c coverageCount) percent() float64 {
+	if c.total == 0 {
+		return 100
+	}
+	return 100 * float64(c.documented) / float64(c.total)
+}
+
+func newDocCoverage() *docCoverage {
+	return &docCoverage{byPackage: make(map[string]*coverageCount)}
+}
+
+// countCoverage records the documented exported identifiers of the package.
+// Commands and external test packages have no API, so they're not counted.
+func (l *linter) countCoverage(path string, pkg *ast.Package) {
+	if pkg.Name == "main" || strings.HasSuffix(pkg.Name, "_test") {
+		return
+	}
+
+	var count coverageCount
+	add := func(name string, doc ...*ast.CommentGroup) {
+		if !ast.IsExported(name) {
+			return
+		}
+		count.total++
+		for _, d := range doc {
+			if d != nil {
+				count.documented++
+				return
+			}
+		}
+	}
+	for filename, f := range pkg.Files {
+		if !l.isOwned(filename) {
+			continue
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				d := funcDeclDoc(decl)
+				if d.recv == "" || ast.IsExported(d.recv) {
+					add(d.name, decl.Doc)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						add(spec.Name.Name, spec.Doc, decl.Doc)
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							add(name.Name, spec.Doc, decl.Doc)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := path + " " + pkg.Name
+	if c := l.coverage.byPackage[key]; c != nil {
+		c.documented += count.documented
+		c.total += count.total
+	} else {
+		l.coverage.byPackage[key] = &count
+	}
+}
+
+// Total returns the coverage of all checked packages.
+func (c *docCoverage) Total() coverageCount {
+	var total coverageCount
+	for _, count := range c.byPackage {
+		total.documented += count.documented
+		total.total += count.total
+	}
+	return total
+}
+
+func (c *docCoverage) Print(w io.Writer) {
+	keys := make([]string, 0, len(c.byPackage))
+	for k := range c.byPackage {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(w, "documentation coverage:\n")
+	for _, k := range keys {
+		count := c.byPackage[k]
+		path, name, _ := strings.Cut(k, " ")
+		fmt.Fprintf(w, "  %5.1f%% %4d/%-4d %s (%s)\n", count.percent(), count.documented, count.total, path, name)
+	}
+	total := c.Total()
+	fmt.Fprintf(w, "  %5.1f%% %4d/%-4d total\n", total.percent(), total.documented, total.total)
+}
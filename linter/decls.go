@@ -0,0 +1,138 @@
+package linter
+
+import (
+	"go/ast"
+	"sort"
+)
+
+// declDoc is a doc-comment of a package-level declaration.
+type declDoc struct {
+	doc *ast.CommentGroup
+
+	// name is the documented declaration name.
+	// It's empty for the doc of a grouped declaration.
+	name string
+
+	// recv is a method receiver type name, empty for non-methods.
+	recv string
+
+	// node is the documented *ast.FuncDecl, *ast.GenDecl,
+	// *ast.TypeSpec or *ast.ValueSpec.
+	node ast.Node
+}
+
+// collectDeclDocs returns docs of all package-level declarations
+// of the package, ordered by their position.
+func collectDeclDocs(pkg *ast.Package) []declDoc {
+	var docs []declDoc
+	for _, f := range sortedFiles(pkg) {
+		docs = append(docs, fileDeclDocs(f)...)
+	}
+	return docs
+}
+
+// fileDeclDocs returns docs of all package-level declarations of the file.
+func fileDeclDocs(f *ast.File) []declDoc {
+	var docs []declDoc
+	add := func(doc *ast.CommentGroup, name, recv string, node ast.Node) {
+		if doc != nil {
+			docs = append(docs, declDoc{doc: doc, name: name, recv: recv, node: node})
+		}
+	}
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			d := funcDeclDoc(decl)
+			add(d.doc, d.name, d.recv, decl)
+		case *ast.GenDecl:
+			// Single spec declaration doc belongs to that spec.
+			self := ""
+			if len(decl.Specs) == 1 {
+				switch spec := decl.Specs[0].(type) {
+				case *ast.TypeSpec:
+					self = spec.Name.Name
+				case *ast.ValueSpec:
+					self = spec.Names[0].Name
+				}
+			}
+			add(decl.Doc, self, "", decl)
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					add(spec.Doc, spec.Name.Name, "", spec)
+				case *ast.ValueSpec:
+					add(spec.Doc, spec.Names[0].Name, "", spec)
+				}
+			}
+		}
+	}
+	return docs
+}
+
+// funcDeclDoc returns the function declaration doc.
+func funcDeclDoc(decl *ast.FuncDecl) declDoc {
+	recv := ""
+	if decl.Recv != nil && len(decl.Recv.List) != 0 {
+		recv = recvTypeName(decl.Recv.List[0].Type)
+	}
+	return declDoc{doc: decl.Doc, name: decl.Name.Name, recv: recv, node: decl}
+}
+
+// packageIdents returns names of all package-level declarations,
+// methods are not included.
+func packageIdents(pkg *ast.Package) map[string]bool {
+	idents := make(map[string]bool)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					idents[decl.Name.Name] = true
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						idents[spec.Name.Name] = true
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							idents[name.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return idents
+}
+
+// sortedFiles returns package files ordered by their names.
+func sortedFiles(pkg *ast.Package) []*ast.File {
+	filenames := make([]string, 0, len(pkg.Files))
+	for filename := range pkg.Files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	files := make([]*ast.File, len(filenames))
+	for i, filename := range filenames {
+		files[i] = pkg.Files[filename]
+	}
+	return files
+}
+
+// recvTypeName returns the receiver base type name.
+func recvTypeName(typ ast.Expr) string {
+	switch typ := typ.(type) {
+	case *ast.StarExpr:
+		return recvTypeName(typ.X)
+	case *ast.IndexExpr:
+		return recvTypeName(typ.X)
+	case *ast.IndexListExpr:
+		return recvTypeName(typ.X)
+	case *ast.ParenExpr:
+		return recvTypeName(typ.X)
+	case *ast.Ident:
+		return typ.Name
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// deprecatedRegexp matches the deprecation notice look-alikes
+// like "DEPRECATED:", "deprecated:" or "Deprecated -".
+var deprecatedRegexp = regexp.MustCompile(`(?i)^deprecated\b\s*[:\-–—]?`)
+
+var (
+	// replacementRegexp matches the deprecation notice phrases that
+	// name a replacement or state there is none, like "Use Y instead".
+	// The doc links, like [Y], are the replacement mentions too.
+	replacementRegexp = regexp.MustCompile(`(?i)\b(?:use|instead|in favou?r of|replaced by|superseded by|prefer|see|migrate to|switch to|replacement|alternative|no longer|no-?op|has no effect)\b|\[[^\]]+\]`)
+
+	// noUseRegexp matches the "do not use" phrases, which
+	// don't tell what to use.
+	noUseRegexp = regexp.MustCompile(`(?i)\b(?:do not|don't|never|should not|shouldn't)\s+use\b`)
+)
+
+// checkDeprecated checks the deprecation notice is recognized by
+// the tools: it's a "Deprecated: " paragraph that follows the
+// synopsis, so the doc still explains what the symbol does.
+func (c *checker) checkDeprecated(doc *ast.CommentGroup) {
+	prevBlank := true
+	for i, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok {
+			return
+		}
+		text = strings.TrimPrefix(text, " ")
+		if strings.TrimSpace(text) == "" {
+			prevBlank = true
+			continue
+		}
+		blank := prevBlank
+		prevBlank = false
+
+		loc := deprecatedRegexp.FindStringIndex(text)
+		if loc == nil {
+			continue
+		}
+		notice := text[loc[0]:loc[1]]
+		switch {
+		case !strings.HasPrefix(text, "Deprecated: "):
+			if strings.TrimSpace(notice) == "deprecated" && !blank {
+				// Likely a prose, like "deprecated in favor of".
+				continue
+			}
+			c.warnComment("deprecated", comment, "deprecation notice should start with \"Deprecated: \", not %q", notice)
+		case i == 0:
+			c.warnComment("deprecated", comment, "deprecation notice hides the synopsis, move it to a trailing \"Deprecated: \" paragraph")
+		case !blank:
+			c.warnComment("deprecated", comment, "deprecation notice should start a new paragraph to be recognized")
+		}
+		if strings.HasPrefix(text, "Deprecated: ") {
+			c.checkDeprecationReplacement(doc.List[i:])
+		}
+		return
+	}
+}
+
+// checkDeprecationReplacement checks that the deprecation notice
+// paragraph starting the lines tells what to use instead, or states
+// there is no replacement. A bare "Deprecated: do not use" leaves
+// the users guessing.
+func (c *checker) checkDeprecationReplacement(lines []*ast.Comment) {
+	var notice []string
+	for _, comment := range lines {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || strings.TrimSpace(text) == "" || isDirective(comment.Text) {
+			break
+		}
+		notice = append(notice, strings.TrimSpace(text))
+	}
+	text := noUseRegexp.ReplaceAllString(strings.Join(notice, " "), "")
+	if !replacementRegexp.MatchString(strings.TrimPrefix(text, "Deprecated: ")) {
+		c.warnComment("deprecated-replacement", lines[0], "deprecation notice should name a replacement, like \"Use X instead\", or say there is none")
+	}
+}
@@ -0,0 +1,173 @@
+package linter
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// changedLines maps absolute file names to their changed line numbers.
+type changedLines map[string]map[int]bool
+
+// Touches reports whether any of the [from, to] lines of filename is changed.
+func (cl changedLines) Touches(filename string, from, to int) bool {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return false
+	}
+	lines := cl[abs]
+	for line := from; line <= to; line++ {
+		if lines[line] {
+			return true
+		}
+	}
+	return false
+}
+
+// gitDiff returns the lines changed since the base revision.
+func gitDiff(base string) (changedLines, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "diff", "--no-color", "--no-ext-diff", "--relative", "-U0", base)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return parseDiff(bytes.NewReader(out))
+}
+
+// gitChangedDirs returns directories of the Go files that are
+// modified, staged or untracked in the current git work tree.
+func gitChangedDirs() ([]string, error) {
+	commands := [][]string{
+		{"diff", "--name-only", "--no-ext-diff", "--relative", "--diff-filter=ACMR", "HEAD"},
+		{"ls-files", "--others", "--exclude-standard"},
+	}
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, args := range commands {
+		var stderr bytes.Buffer
+		cmd := exec.Command("git", args...)
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		for _, name := range strings.Split(string(out), "\n") {
+			if !strings.HasSuffix(name, ".go") {
+				continue
+			}
+			dir := filepath.Dir(filepath.FromSlash(name))
+			if !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	return dirs, nil
+}
+
+// parseDiff collects added and modified lines from the unified diff.
+//
+// File names are resolved relative to the current directory,
+// git "a/" and "b/" prefixes are removed. The file headers are
+// only recognized outside of the hunks, so the added "++ x" line
+// is not taken for a "+++ x" header.
+func parseDiff(r io.Reader) (changedLines, error) {
+	changed := make(changedLines)
+	var lines map[int]bool
+	line := 0
+	// oldLeft and newLeft are the old and new lines left in the hunk.
+	oldLeft, newLeft := 0, 0
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		text := s.Text()
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				if lines != nil {
+					lines[line] = true
+				}
+				line++
+				newLeft--
+			case strings.HasPrefix(text, "-"):
+				oldLeft--
+			case strings.HasPrefix(text, " ") || text == "":
+				line++
+				oldLeft--
+				newLeft--
+			}
+			// "\ No newline at end of file" doesn't count.
+			continue
+		}
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			name := strings.TrimPrefix(text, "+++ ")
+			if i := strings.IndexByte(name, '\t'); i != -1 {
+				name = name[:i]
+			}
+			if name == "/dev/null" {
+				lines = nil
+				continue
+			}
+			name = strings.TrimPrefix(name, "b/")
+			abs, err := filepath.Abs(name)
+			if err != nil {
+				return nil, err
+			}
+			lines = changed[abs]
+			if lines == nil {
+				lines = make(map[int]bool)
+				changed[abs] = lines
+			}
+		case strings.HasPrefix(text, "--- "):
+			// Old file name is not interesting.
+		case strings.HasPrefix(text, "@@ "):
+			start, oldCount, newCount, err := parseHunkHeader(text)
+			if err != nil {
+				return nil, err
+			}
+			line, oldLeft, newLeft = start, oldCount, newCount
+		}
+	}
+	return changed, s.Err()
+}
+
+// parseHunkHeader returns the first new file line and the old and
+// new line counts of the "@@ -l,s +l,s @@" hunk header. The omitted
+// counts are 1.
+func parseHunkHeader(header string) (start, oldCount, newCount int, err error) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 || !strings.HasPrefix(fields[1], "-") || !strings.HasPrefix(fields[2], "+") {
+		return 0, 0, 0, fmt.Errorf("malformed hunk header: %q", header)
+	}
+	_, oldCount, err = parseHunkRange(fields[1][len("-"):])
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("malformed hunk header: %q", header)
+	}
+	start, newCount, err = parseHunkRange(fields[2][len("+"):])
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("malformed hunk header: %q", header)
+	}
+	return start, oldCount, newCount, nil
+}
+
+// parseHunkRange parses the "l,s" or "l" hunk range.
+func parseHunkRange(r string) (start, count int, err error) {
+	startText, countText, ok := strings.Cut(r, ",")
+	start, err = strconv.Atoi(startText)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ok {
+		return start, 1, nil
+	}
+	count, err = strconv.Atoi(countText)
+	return start, count, err
+}
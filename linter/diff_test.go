@@ -0,0 +1,125 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseDiff(t *testing.T) {
+	diff := `diff --git a/a.go b/a.go
+--- a/a.go
++++ b/a.go
+@@ -2,3 +2,4 @@ package a
+ // A is a.
+-// B is b
++// B is b.
++++ added line that looks like a header
+ func B() {}
+@@ -10,2 +11,2 @@
+--- removed line that looks like a header
+ func C() {}
++// C is c.
+diff --git a/old.go b/old.go
+deleted file mode 100644
+--- a/old.go
++++ /dev/null
+@@ -1,2 +0,0 @@
+-package old
+-
+--- /dev/null
++++ b/new.go	2024-01-01 00:00:00
+@@ -0,0 +1 @@
++package new
+\ No newline at end of file
+`
+	changed, err := parseDiff(strings.NewReader(diff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		file     string
+		from, to int
+		want     bool
+	}{
+		{"a.go", 2, 2, false},
+		{"a.go", 3, 3, true},
+		{"a.go", 4, 4, true},
+		{"a.go", 5, 5, false},
+		{"a.go", 1, 2, false},
+		{"a.go", 1, 3, true},
+		{"a.go", 11, 11, false},
+		{"a.go", 12, 12, true},
+		{"a.go", 13, 13, false},
+		{"new.go", 1, 1, true},
+		{"old.go", 1, 1, false},
+		{"other.go", 3, 3, false},
+	}
+	for _, test := range tests {
+		if have := changed.Touches(test.file, test.from, test.to); have != test.want {
+			t.Errorf("Touches(%s, %d, %d) = %v, want %v", test.file, test.from, test.to, have, test.want)
+		}
+	}
+	abs, err := filepath.Abs("added line that looks like a header")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed[abs] != nil {
+		t.Errorf("the added line is taken for a file header")
+	}
+}
+
+func TestParseDiffMalformedHunk(t *testing.T) {
+	_, err := parseDiff(strings.NewReader("+++ b/a.go\n@@ -1 +x @@\n"))
+	if err == nil {
+		t.Errorf("no error for the malformed hunk header")
+	}
+}
+
+func TestFixDiffLines(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "p.go")
+	src := `// Package p is a test.
+package p
+
+// A is ok
+func A() {}
+
+// B is ok
+func B() {}
+`
+	if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		fix:                        true,
+		sink:                       func(iss issue, sev severity) {},
+		changed:                    changedLines{abs: {7: true}},
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	l.checkDir(dir)
+	if _, err := l.ApplyFixes(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Replace(src, "// B is ok\n", "// B is ok.\n", 1)
+	if string(data) != want {
+		t.Errorf("fixed source:\n%s\nwant:\n%s", data, want)
+	}
+}
@@ -0,0 +1,152 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// dirSettings returns the settings of the packages in the dir.
+//
+// Unless the config is given explicitly, every package uses the
+// defaultConfigFile files of its directory and the parent ones,
+// merged by dirConfig. The packages with the same config files
+// share the settings.
+func (l *linter) dirSettings(dir string) (*settings, error) {
+	if !l.nestedConfigs {
+		return l.settings, nil
+	}
+	chain, err := l.configChain(dir)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.Join(chain, "\x00")
+	l.mu.Lock()
+	s, ok := l.dirConfigs[key]
+	l.mu.Unlock()
+	if ok {
+		return s, nil
+	}
+
+	conf, err := l.mergeConfigs(chain)
+	if err != nil {
+		return nil, err
+	}
+	s, err = newSettings(l.withLanguage(conf), l.severityOverrides)
+	if err != nil {
+		if len(chain) != 0 {
+			return nil, fmt.Errorf("%s: %v", chain[len(chain)-1], err)
+		}
+		return nil, err
+	}
+	l.logger.Info("loaded the config", "path", dir, "files", chain)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.dirConfigs == nil {
+		l.dirConfigs = make(map[string]*settings)
+	}
+	l.dirConfigs[key] = s
+	return s, nil
+}
+
+// dirConfig returns the merged config of the dir, see mergeConfigs.
+func (l *linter) dirConfig(dir string) (*Config, error) {
+	chain, err := l.configChain(dir)
+	if err != nil {
+		return nil, err
+	}
+	return l.mergeConfigs(chain)
+}
+
+// configChain returns the existing defaultConfigFile files
+// of the dir and its parent directories, the outermost first.
+func (l *linter) configChain(dir string) ([]string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	var chain []string
+	for d := abs; ; d = filepath.Dir(d) {
+		filename := filepath.Join(d, defaultConfigFile)
+		data, err := l.loadConfigFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			chain = append(chain, filename)
+		}
+		if parent := filepath.Dir(d); parent == d {
+			break
+		}
+	}
+	slices.Reverse(chain)
+	return chain, nil
+}
+
+// mergeConfigs decodes the config files of the chain on top of the
+// defaults one by one, so the nearer files override the options set
+// by the outer ones. The objects are merged: the options that a file
+// doesn't set keep the outer values, the messages are merged by the
+// check names. The lists, like rules, replace the outer ones.
+//
+// The exclude patterns are only read from the config files of the
+// current directory and its parents, the ones that are applied before
+// the walk. The nested config files with exclude are an error, so the
+// patterns are not silently ignored.
+func (l *linter) mergeConfigs(chain []string) (*Config, error) {
+	root, err := l.configChain(".")
+	if err != nil {
+		return nil, err
+	}
+	conf := defaultConfig()
+	for _, filename := range chain {
+		data, err := l.loadConfigFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(root, filename) {
+			var nested struct {
+				Exclude []string `json:"exclude"`
+			}
+			if err := json.Unmarshal(data, &nested); err == nil && len(nested.Exclude) != 0 {
+				return nil, fmt.Errorf("%s: exclude is only read from the config of the current directory", filename)
+			}
+		}
+		if err := decodeConfig(data, conf); err != nil {
+			return nil, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+	return conf, nil
+}
+
+// loadConfigFile returns the config file contents, or nil
+// if there is no such file. The files are read only once.
+func (l *linter) loadConfigFile(filename string) ([]byte, error) {
+	l.mu.Lock()
+	data, ok := l.configFiles[filename]
+	l.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		data, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.configFiles == nil {
+		l.configFiles = make(map[string][]byte)
+	}
+	l.configFiles[filename] = data
+	return data, nil
+}
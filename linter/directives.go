@@ -0,0 +1,200 @@
+package linter
+
+import (
+	"go/ast"
+	"strings"
+)
+
+// knownDirectives lists the directives recognized by the Go toolchain
+// and the popular tools in their canonical spelling.
+var knownDirectives = []string{
+	"go:build",
+	"go:debug",
+	"go:embed",
+	"go:generate",
+	"go:linkname",
+	"go:noescape",
+	"go:nocheckptr",
+	"go:noinline",
+	"go:norace",
+	"go:nosplit",
+	"go:uintptrescapes",
+	"go:uintptrkeepalive",
+	"go:wasmexport",
+	"go:wasmimport",
+	"lint:file-ignore",
+	"lint:ignore",
+	"nolint",
+}
+
+// checkDirectiveTypo reports comments that look like
+// misspelled directives. These are silently ignored by
+// the tools, so the intended behavior is lost.
+func (c *checker) checkDirectiveTypo(comment *ast.Comment) {
+	if !strings.HasPrefix(comment.Text, "//") {
+		return
+	}
+	body := comment.Text[len("//"):]
+
+	var suggestion string
+	switch {
+	case strings.HasPrefix(body, "go: "):
+		suggestion = "//go:" + strings.TrimLeft(body[len("go:"):], " ")
+	case strings.HasPrefix(body, "go:"):
+		name, rest, _ := strings.Cut(body, " ")
+		if d := closestDirective(name); d != "" {
+			suggestion = "//" + d
+			if rest != "" {
+				suggestion += " " + rest
+			}
+		}
+	case strings.HasPrefix(body, "nolint: "):
+		suggestion = "//nolint:" + strings.TrimLeft(body[len("nolint:"):], " ")
+	case strings.HasPrefix(body, "nolint "):
+		rest := strings.TrimLeft(body[len("nolint"):], " ")
+		if strings.HasPrefix(rest, ":") {
+			suggestion = "//nolint" + rest
+		}
+	}
+	if suggestion == "" {
+		return
+	}
+
+	c.warnComment("directive-typo", comment, "%s looks like a misspelled directive, did you mean %s?",
+		comment.Text, suggestion)
+}
+
+// closestDirective returns a known directive that is a likely
+// intended spelling of name. Empty string is returned if name
+// is already known or there are no close enough candidates.
+func closestDirective(name string) string {
+	best := ""
+	bestDist := 3
+	for _, d := range knownDirectives {
+		if d == name {
+			return ""
+		}
+		if dist := editDistance(name, d); dist < bestDist {
+			best = d
+			bestDist = dist
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// isDirective reports whether the comment line is a machine directive.
+// It follows the go/ast rules: "//line " and "//export " style directives
+// and "//name:args" where name is lowercase ASCII letters and digits.
+func isDirective(text string) bool {
+	body, ok := strings.CutPrefix(text, "//")
+	if !ok {
+		return false
+	}
+	for _, prefix := range []string{"line ", "extern ", "export "} {
+		if strings.HasPrefix(body, prefix) {
+			return true
+		}
+	}
+	colon := strings.IndexByte(body, ':')
+	if colon <= 0 || colon+1 >= len(body) {
+		return false
+	}
+	for i := 0; i <= colon+1; i++ {
+		if i == colon {
+			continue
+		}
+		b := body[i]
+		if !('a' <= b && b <= 'z' || '0' <= b && b <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDirectiveNamespace reports whether ns is a namespace
+// of one of the known directives, like "go" for "go:generate".
+func isDirectiveNamespace(ns string) bool {
+	for _, d := range knownDirectives {
+		if name, _, _ := strings.Cut(d, ":"); name == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDirectiveFormat reports comments that are neither
+// well-formed directives nor well-formed prose.
+//
+// "// go:generate" is a directive that is ignored by the tools
+// because of the space, while "//note: text" is a prose comment
+// that is missing the space and looks like a directive.
+func (c *checker) checkDirectiveFormat(comment *ast.Comment) {
+	body, ok := strings.CutPrefix(comment.Text, "// ")
+	if ok {
+		body = strings.TrimLeft(body, " ")
+		ns, _, _ := strings.Cut(body, ":")
+		if isDirective("//"+body) && isDirectiveNamespace(ns) {
+			c.warnComment("directive-format", comment, "%s is not a directive because of the space, use //%s",
+				comment.Text, body)
+		}
+		return
+	}
+	if isDirective(comment.Text) {
+		return
+	}
+	if c.regexp.directiveLike.MatchString(comment.Text) {
+		ns, _, _ := strings.Cut(comment.Text[len("//"):], ":")
+		if isDirectiveNamespace(ns) {
+			// Misspelled directive, reported by the directive-typo check.
+			return
+		}
+		c.warnComment("directive-format", comment, "%s is not a valid directive, add a space after // if it's a comment",
+			comment.Text)
+	}
+}
+
+// checkDirectivePlacement reports directives that are followed
+// by the doc-comment prose. The convention is to put directives
+// after the human-readable text, so go/doc can strip them
+// without breaking the paragraphs.
+func (c *checker) checkDirectivePlacement(doc *ast.CommentGroup) {
+	var directive *ast.Comment
+	for _, comment := range doc.List {
+		switch {
+		case isBuildConstraint(comment.Text):
+			// Reported by the build-constraint check.
+			return
+		case isDirective(comment.Text):
+			if directive == nil {
+				directive = comment
+			}
+		case comment.Text == "//":
+			// Empty lines don't break the directives block.
+		case directive != nil:
+			c.warnComment("directive-placement", directive, "%s directive should go after the doc-comment text",
+				directive.Text)
+			return
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// Package linter implements the doccheck doc-comment checks.
+//
+// Lint checks the already parsed files and reports the findings as
+// Diagnostic values, Main runs the doccheck command.
+package linter
@@ -0,0 +1,108 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var wordRegexp = regexp.MustCompile(`\w+`)
+
+// minDupDocWords is a min number of words in a doc-comment
+// for it to be checked for duplication. Very short docs like
+// "X returns x." are expected to look alike.
+const minDupDocWords = 5
+
+// checkDuplicateDocs finds doc-comments that are (nearly) identical
+// to a doc-comment of another declaration of the same package,
+// which is a strong sign of a copy-paste that was never updated.
+//
+// Docs are compared with the documented names stripped, so
+// "Foo does X" and "Bar does X" are considered identical.
+// Methods with the same name are not compared, as implementing
+// the same interface method is expected to result in similar docs.
+func (c *checker) checkDuplicateDocs(pkg *ast.Package) {
+	if c.duplicateDocSimilarity <= 0 {
+		return
+	}
+
+	type normalizedDoc struct {
+		declDoc
+		words []string
+	}
+	var docs []normalizedDoc
+	for _, d := range collectDeclDocs(pkg) {
+		if d.name == "" || !c.isCheckedDecl(d) {
+			continue
+		}
+		words := docWords(d.doc.Text(), d.name)
+		if len(words) < minDupDocWords {
+			continue
+		}
+		docs = append(docs, normalizedDoc{declDoc: d, words: words})
+	}
+
+	for i, d := range docs {
+		for _, prev := range docs[:i] {
+			if d.recv != "" && prev.recv != "" && d.name == prev.name {
+				continue
+			}
+			// Cheap length filter before the expensive distance computation.
+			shorter, longer := len(d.words), len(prev.words)
+			if shorter > longer {
+				shorter, longer = longer, shorter
+			}
+			if float64(shorter)/float64(longer) < c.duplicateDocSimilarity {
+				continue
+			}
+			similarity := 1 - float64(wordsDistance(d.words, prev.words))/float64(longer)
+			if similarity < c.duplicateDocSimilarity {
+				continue
+			}
+			c.warnGroup("duplicate-doc", d.doc, "doc-comment is a copy of the %s doc (%.0f%% similar)",
+				qualifiedDeclName(prev.declDoc), similarity*100)
+			break
+		}
+	}
+}
+
+func qualifiedDeclName(d declDoc) string {
+	if d.recv != "" {
+		return d.recv + "." + d.name
+	}
+	return d.name
+}
+
+// docWords returns lowercased doc words with the names occurrences removed.
+func docWords(text string, names ...string) []string {
+	var words []string
+	for _, w := range wordRegexp.FindAllString(text, -1) {
+		if slices.Contains(names, w) {
+			continue
+		}
+		words = append(words, strings.ToLower(w))
+	}
+	return words
+}
+
+// wordsDistance is a word-level edit distance between a and b.
+func wordsDistance(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
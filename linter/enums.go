@@ -0,0 +1,52 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+)
+
+// checkEnumDocs checks the iota const blocks of the exported types.
+// Such a block should have a doc-comment that mentions the enum type,
+// or every constant of the block should be documented.
+func (c *checker) checkEnumDocs(f *ast.File) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST || len(decl.Specs) == 0 {
+			continue
+		}
+		first := decl.Specs[0].(*ast.ValueSpec)
+		typ, ok := first.Type.(*ast.Ident)
+		if !ok || !typ.IsExported() || !usesIota(first) {
+			continue
+		}
+
+		if decl.Doc != nil {
+			if !slices.Contains(wordRegexp.FindAllString(decl.Doc.Text(), -1), typ.Name) {
+				c.warnGroup("enum-doc", decl.Doc, "doc-comment of the %s constants should mention the %s type", typ.Name, typ.Name)
+			}
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			if spec.Doc == nil && spec.Comment == nil && spec.Names[0].IsExported() {
+				c.warnIdent("enum-doc", first.Names[0], "%s constants should have a block doc-comment or be documented one by one", typ.Name)
+				break
+			}
+		}
+	}
+}
+
+// usesIota reports whether the constant value refers to iota.
+func usesIota(spec *ast.ValueSpec) bool {
+	found := false
+	for _, v := range spec.Values {
+		ast.Inspect(v, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && ident.Name == "iota" {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
@@ -0,0 +1,132 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// errorMentionRegexp matches the doc words describing the error
+// behavior, like "error", "fails" or a sentinel error name.
+var errorMentionRegexp = regexp.MustCompile(`(?i:\berr(?:or|ors)?\b|\bfail(?:s|ed|ing|ure|ures)?\b)|\bErr[A-Z0-9]\w*|\bEOF\b`)
+
+var (
+	// sentinelNameRegexp matches the conventional sentinel error names.
+	sentinelNameRegexp = regexp.MustCompile(`^Err(?:[A-Z0-9_]|$)`)
+
+	// errorPhraseRegexp matches the conventional phrasing of the error
+	// value and type docs that follows the name, like "is returned when".
+	errorPhraseRegexp = regexp.MustCompile(`^(?:(?:is|are) (?:returned|the error|an? (?:\w+ )?error)|means|indicates|signals|reports|describes|records|represents)\b`)
+)
+
+// errorDocExempt are the well-known interface methods,
+// which error behavior is documented by the interface.
+var errorDocExempt = map[string]bool{
+	"Read":            true,
+	"Write":           true,
+	"Close":           true,
+	"Seek":            true,
+	"ReadFrom":        true,
+	"WriteTo":         true,
+	"MarshalJSON":     true,
+	"UnmarshalJSON":   true,
+	"MarshalText":     true,
+	"UnmarshalText":   true,
+	"MarshalBinary":   true,
+	"UnmarshalBinary": true,
+}
+
+// checkErrorDoc checks that the exported functions returning
+// an error describe when they fail. The check is for the library
+// authors that document their error contracts, so it's opt-in.
+func (c *checker) checkErrorDoc(doc *ast.CommentGroup) {
+	fn := c.current.fn
+	if !c.errorDocs || !ast.IsExported(fn.Name.Name) || !returnsError(fn.Type) {
+		return
+	}
+	if fn.Recv != nil && errorDocExempt[fn.Name.Name] {
+		return
+	}
+	if !errorMentionRegexp.MatchString(doc.Text()) {
+		c.warnFunc("error-doc", "%s returns an error, its doc-comment should describe when it fails", fn.Name.Name)
+	}
+}
+
+// checkErrorDecls checks the docs of the exported sentinel errors,
+// like ErrNotFound, and the error types, like SyntaxError. These are
+// a part of the API contract, so the undocumented ones are reported,
+// and the docs should tell when the error is returned.
+//
+// The values of a documented group and the ones with a line comment
+// are documented by them, the phrasing is not checked for these.
+func (c *checker) checkErrorDecls(pkg *ast.Package) {
+	if pkg.Name == "main" || strings.HasSuffix(pkg.Name, "_test") {
+		return
+	}
+	for _, filename := range sortedKeys(pkg.Files) {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		for _, decl := range pkg.Files[filename].Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				var ident *ast.Ident
+				var doc, comment *ast.CommentGroup
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if sentinelNameRegexp.MatchString(name.Name) {
+							ident = name
+							break
+						}
+					}
+					doc, comment = spec.Doc, spec.Comment
+				case *ast.TypeSpec:
+					if spec.Name.IsExported() && strings.HasSuffix(spec.Name.Name, "Error") {
+						ident = spec.Name
+					}
+					doc, comment = spec.Doc, spec.Comment
+				}
+				if ident == nil {
+					continue
+				}
+				if doc == nil && !decl.Lparen.IsValid() {
+					doc = decl.Doc
+				}
+				switch {
+				case doc == nil && comment == nil && decl.Doc == nil:
+					c.warnIdent("error-value-doc", ident, "%s is undocumented, document when the error is returned", ident.Name)
+				case doc != nil:
+					c.checkErrorPhrase(ident, doc)
+				}
+			}
+		}
+	}
+}
+
+// checkErrorPhrase checks the error doc synopsis follows the name with
+// the conventional phrase, like "ErrClosed is returned when ...".
+// The docs that don't start with the name are reported by other checks.
+func (c *checker) checkErrorPhrase(ident *ast.Ident, doc *ast.CommentGroup) {
+	rest, ok := strings.CutPrefix(normalizeSpace(doc.Text()), ident.Name+" ")
+	if !ok || errorPhraseRegexp.MatchString(rest) {
+		return
+	}
+	c.warnIdent("error-value-doc", ident, "%s doc-comment should say when the error is returned, like \"%s is returned when ...\"", ident.Name, ident.Name)
+}
+
+// returnsError reports whether one of the function results is an error.
+func returnsError(typ *ast.FuncType) bool {
+	if typ.Results == nil {
+		return false
+	}
+	for _, field := range typ.Results.List {
+		if id, ok := field.Type.(*ast.Ident); ok && id.Name == "error" {
+			return true
+		}
+	}
+	return false
+}
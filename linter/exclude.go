@@ -0,0 +1,44 @@
+package linter
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// compileExcludes converts the -exclude glob patterns to regexps.
+// The patterns have the same syntax as the CODEOWNERS ones,
+// like "**/mocks/**" or "zz_generated*.go".
+func compileExcludes(patterns []string) []*regexp.Regexp {
+	var excludes []*regexp.Regexp
+	for _, pat := range patterns {
+		if pat = strings.TrimSpace(pat); pat != "" {
+			excludes = append(excludes, compileOwnersPattern(pat))
+		}
+	}
+	return excludes
+}
+
+// isExcluded reports whether the file or directory path
+// matches one of the -exclude patterns. The absolute paths are
+// made relative to the current directory, the config root,
+// so they match the same patterns as the relative ones.
+func (l *linter) isExcluded(path string) bool {
+	if len(l.excludes) == 0 {
+		return false
+	}
+	if filepath.IsAbs(path) && l.excludeRoot != "" {
+		if rel, err := filepath.Rel(l.excludeRoot, path); err == nil {
+			path = rel
+		}
+	}
+	path = strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "./")
+	for _, re := range l.excludes {
+		// "dir/**" patterns match only the paths inside dir,
+		// so the directory itself is matched with a trailing slash.
+		if re.MatchString(path) || re.MatchString(path+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package linter
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestIsExcluded(t *testing.T) {
+	root := t.TempDir()
+	l := &linter{
+		excludes:    compileExcludes([]string{"gen/**", "**/mocks/**", "zz_generated*.go", "/tools"}),
+		excludeRoot: root,
+	}
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{"gen", true},
+		{"./gen/a.go", true},
+		{"pkg/mocks", true},
+		{"pkg/mocks/a.go", true},
+		{"pkg/zz_generated.deepcopy.go", true},
+		{"tools", true},
+		{"pkg/tools", false},
+		{"pkg/a.go", false},
+
+		{filepath.Join(root, "gen"), true},
+		{filepath.Join(root, "gen", "a.go"), true},
+		{filepath.Join(root, "pkg", "mocks", "a.go"), true},
+		{filepath.Join(root, "pkg", "zz_generated.deepcopy.go"), true},
+		{filepath.Join(root, "tools"), true},
+		{filepath.Join(root, "pkg", "tools"), false},
+		{filepath.Join(root, "pkg", "a.go"), false},
+		{filepath.Join(filepath.Dir(root), "gen", "a.go"), false},
+	}
+	for _, test := range tests {
+		if have := l.isExcluded(test.path); have != test.want {
+			t.Errorf("isExcluded(%q): have %v, want %v", test.path, have, test.want)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// explanation is a detailed check documentation for the explain subcommand.
+type explanation struct {
+	// rationale tells why the check exists.
+	rationale string
+
+	// bad and good are the examples of the reported
+	// and the accepted code.
+	bad  string
+	good string
+
+	// disable tells how to turn the check off if it
+	// has a dedicated option, in addition to -severity.
+	disable string
+}
+
+var explanations = map[string]explanation{
+	"parse": {
+		rationale: "Files that can't be parsed can't be checked, and go doc can't render them either.",
+	},
+	"package-doc": {
+		rationale: "The package doc-comment is the first thing the readers see on pkg.go.dev. " +
+			"When several files have one, go/doc concatenates them in an unpredictable order.",
+		bad:  "package bytes",
+		good: "// Package bytes implements functions for the manipulation of byte slices.\npackage bytes",
+	},
+	"doc-go": {
+		rationale: "Long package docs are easier to maintain in a dedicated doc.go file " +
+			"than above the package clause of some arbitrary file.",
+		disable: `configure the policy with "doc_go": {"max_lines": 100, "check_main": false, "strict": false}`,
+	},
+	"package-prefix": {
+		rationale: "go/doc and pkg.go.dev use the first sentence as the package synopsis, " +
+			"and the package lists read best when all of them start the same way.",
+		bad:  "// Utilities for byte slices.\npackage bytes",
+		good: "// Package bytes implements functions for the manipulation of byte slices.\npackage bytes",
+	},
+	"package-synopsis": {
+		rationale: "The synopsis is shown in the package lists, where the long ones are truncated.",
+		bad:       "// Package bytes implements functions for the manipulation of byte slices and also\n// buffers, readers and a lot more\npackage bytes",
+		good:      "// Package bytes implements functions for the manipulation of byte slices.\npackage bytes",
+		disable:   `adjust the limit with "max_synopsis_length"`,
+	},
+	"command-doc": {
+		rationale: "Command docs describe the program, not the Go package, so they start with the command name.",
+		bad:       "// Package main runs the server.\npackage main",
+		good:      "// Serverd runs the server.\npackage main",
+	},
+	"command-usage": {
+		rationale: "Command docs are the only place where go doc users can learn how to run the program.",
+		good:      "// Serverd runs the server.\n//\n// Usage:\n//\n//\tserverd [flags] [config]\npackage main",
+		disable:   `remove "command_usage": true from the config`,
+	},
+	"predicate": {
+		rationale: "\"reports whether\" is the conventional phrasing of the standard library, " +
+			"it also reads well for the conditions that can't be simply true or false.",
+		bad:     "// IsEmpty returns true if the list is empty.\nfunc (l *List) IsEmpty() bool",
+		good:    "// IsEmpty reports whether the list is empty.\nfunc (l *List) IsEmpty() bool",
+		disable: `configure the name prefixes and phrases in "predicate"`,
+	},
+	"this-opening": {
+		rationale: "Docs that start with the documented name read as complete sentences in go doc output and search.",
+		bad:       "// This function parses the config.\nfunc Parse() error",
+		good:      "// Parse parses the config.\nfunc Parse() error",
+	},
+	"name-typo": {
+		rationale: "A doc that starts with almost the documented name is usually left stale after a rename.",
+		bad:       "// Procesx handles the request.\nfunc Process()",
+		good:      "// Process handles the request.\nfunc Process()",
+	},
+	"method-doc": {
+		rationale: "go/doc lists methods under their types, so the receiver type name is redundant.",
+		bad:       "// Buffer.Len returns the number of unread bytes.\nfunc (b *Buffer) Len() int",
+		good:      "// Len returns the number of unread bytes.\nfunc (b *Buffer) Len() int",
+	},
+	"method-docs": {
+		rationale: "All methods of the type are reported together to show how complete its API documentation is.",
+	},
+	"type-alias": {
+		rationale: "go/doc shows the aliases separately from the aliased types, " +
+			"so their docs should explain why the alias exists.",
+		bad:  "type Reader = bytes.Reader",
+		good: "// Reader is an alias of bytes.Reader kept for compatibility.\ntype Reader = bytes.Reader",
+	},
+	"enum-doc": {
+		rationale: "Enum constants are how the readers learn the valid values of the type.",
+		bad:       "const (\n\tRed Color = iota\n\tGreen\n)",
+		good:      "// Color values.\nconst (\n\tRed Color = iota\n\tGreen\n)",
+	},
+	"type-param-doc": {
+		rationale: "Generic APIs with several type parameters are hard to use without knowing what each one stands for.",
+		bad:       "// Map is a map.\ntype Map[K comparable, V any] struct{}",
+		good:      "// Map maps the K keys to the V values.\ntype Map[K comparable, V any] struct{}",
+		disable:   `remove "type_param_docs" from the config`,
+	},
+	"receiver-name": {
+		rationale: "Go has no this or self, the receiver is referred to by its name.",
+		bad:       "// Reset clears this.buf.\nfunc (b *Buffer) Reset()",
+		good:      "// Reset clears b.buf.\nfunc (b *Buffer) Reset()",
+		disable:   `remove "receiver_name": true from the config`,
+	},
+	"constructor-doc": {
+		rationale: "Constructor docs read uniformly when they tell what is returned.",
+		bad:       "// NewReader is a reader constructor.\nfunc NewReader(b []byte) *Reader",
+		good:      "// NewReader returns a new Reader reading from b.\nfunc NewReader(b []byte) *Reader",
+	},
+	"panic-doc": {
+		rationale: "Panics on the invalid arguments are a part of the function contract. " +
+			"Every reachable panic call of an exported function is reported, except for the ones " +
+			"in the function literals and the \"impossible\" assertions marked as such by the " +
+			"message or a comment, like \"unreachable\" or \"can't happen\".",
+		bad:  "// MustParse parses the config.\nfunc MustParse(s string) *Config",
+		good: "// MustParse is like Parse but panics if the config can't be parsed.\nfunc MustParse(s string) *Config",
+	},
+	"param-ref": {
+		rationale: "References to the parameters that don't exist are usually left after a rename.",
+		bad:       "// Load loads the config from cfg.\nfunc Load(path string) (*Config, error)",
+		good:      "// Load loads the config from the path file.\nfunc Load(path string) (*Config, error)",
+	},
+	"accessor-doc": {
+		rationale: "Getters and setters read uniformly when they are documented the same way.",
+		bad:       "// Name reports whether the name.\nfunc (u *User) Name() string",
+		good:      "// Name returns the user name.\nfunc (u *User) Name() string",
+		disable:   `remove "accessor_docs": true from the config`,
+	},
+	"no-multiline": {
+		rationale: "Go doc-comments are conventionally // comments, /**/ are for the commented out code.",
+		bad:       "/* Parse parses the config. */\nfunc Parse() error",
+		good:      "// Parse parses the config.\nfunc Parse() error",
+	},
+	"ends-with-punct": {
+		rationale: "Doc-comments are complete sentences.",
+		bad:       "// Parse parses the config\nfunc Parse() error",
+		good:      "// Parse parses the config.\nfunc Parse() error",
+	},
+	"spacing": {
+		rationale: "gofmt-formatted code separates the comment text from // with a space.",
+		bad:       "//Parse parses the config.\nfunc Parse() error",
+		good:      "// Parse parses the config.\nfunc Parse() error",
+	},
+	"trailing-space": {
+		rationale: "Trailing whitespace is invisible noise in the diffs.",
+	},
+	"trailing-empty-line": {
+		rationale: "An empty // line at the end of a doc-comment is not rendered and only adds noise.",
+		bad:       "// Parse parses the config.\n//\nfunc Parse() error",
+		good:      "// Parse parses the config.\nfunc Parse() error",
+	},
+	"typography": {
+		rationale: "Typographic quotes and dashes are usually pasted from the word processors, " +
+			"and they are hard to type and search for.",
+		bad:  "// Parse parses the \u201cconfig\u201d \u2014 a JSON file.\nfunc Parse() error",
+		good: "// Parse parses the \"config\", a JSON file.\nfunc Parse() error",
+	},
+	"first-paragraph": {
+		rationale: "The first paragraph is shown in the package index, short synopses are easier to scan.",
+		disable:   "adjust the limit with -max-first-paragraph-sentences",
+	},
+	"code-block": {
+		rationale: "go/doc renders only the indented lines as code, the rest are merged into the prose.",
+		bad:       "// Example:\n// ```\n// x := Parse()\n// ```",
+		good:      "// Example:\n//\n//\tx := Parse()",
+		disable:   `remove "parse_code_blocks": true from the config to skip the syntax check`,
+	},
+	"url": {
+		rationale: "pkg.go.dev auto-links only the plain URLs that are not wrapped or glued to the punctuation.",
+		bad:       "// See [the spec](https://go.dev/ref/spec).",
+		good:      "// See the [Go spec].\n//\n// [Go spec]: https://go.dev/ref/spec",
+	},
+	"deprecated": {
+		rationale: "Tools recognize the deprecations only by the \"Deprecated: \" paragraph.",
+		bad:       "// Parse parses the config.\n// DEPRECATED: use ParseFile.",
+		good:      "// Parse parses the config.\n//\n// Deprecated: Use ParseFile instead.",
+	},
+	"bug-note": {
+		rationale: "go doc collects BUG(owner) notes into a separate section only in that exact form.",
+		bad:       "// BUG: doesn't handle the empty input.",
+		good:      "// BUG(alice): doesn't handle the empty input.",
+	},
+	"interface-doc-dup": {
+		rationale: "A copy of the interface method doc gets outdated and tells nothing specific to the implementation.",
+	},
+	"directive-typo": {
+		rationale: "Misspelled directives are silently ignored by the tools.",
+		bad:       "//go:generates stringer -type=Color",
+		good:      "//go:generate stringer -type=Color",
+	},
+	"directive-placement": {
+		rationale: "go/doc hides the directives only when they follow the doc-comment text.",
+		bad:       "//go:noinline\n// Parse parses the config.\nfunc Parse() error",
+		good:      "// Parse parses the config.\n//\n//go:noinline\nfunc Parse() error",
+	},
+	"directive-format": {
+		rationale: "Directives must have no space after //, otherwise they are regular comments.",
+		bad:       "// go:generate stringer -type=Color",
+		good:      "//go:generate stringer -type=Color",
+	},
+	"identifier-style": {
+		rationale: "Identifiers mentioned the same way are easier to recognize in the prose.",
+		disable:   `remove "identifier_style" from the config`,
+	},
+	"doc-tag": {
+		rationale: "go doc renders the tags of the other languages verbatim, " +
+			"the parameters, results and errors are described in the prose instead.",
+		bad:  "// Parse parses the config.\n// @param path the config file path\n// @return the parsed config\nfunc Parse(path string) *Config",
+		good: "// Parse parses the config from the path file.\nfunc Parse(path string) *Config",
+	},
+	"markdown": {
+		rationale: "go/doc supports only a small subset of Markdown: the # headings, lists and [Name] links. " +
+			"The code spans and the bold text are rendered with the markers, the -fix removes the bold ones. " +
+			"Markdown links are reported by the url check.",
+		bad:     "// Parse parses the **config**, see `Load`.\nfunc Parse() error",
+		good:    "// Parse parses the config, see [Load].\nfunc Parse() error",
+		disable: `code spans are not reported with "identifier_style": "quoted" or "consistent"`,
+	},
+	"initialism": {
+		rationale: "Go spells the initialisms in a consistent case, like URL or url, and the docs read best " +
+			"when they follow the code. The names mentioned with the initialisms spelled differently " +
+			"are hard to search for.",
+		bad:     "// ServeHttp serves the request by its Id.\nfunc ServeHTTP(w http.ResponseWriter, r *http.Request)",
+		good:    "// ServeHTTP serves the request by its ID.\nfunc ServeHTTP(w http.ResponseWriter, r *http.Request)",
+		disable: `extend the default list with "initialisms": ["GRPC"]`,
+	},
+	"filler-word": {
+		rationale: "Filler words make the docs longer without adding any information, " +
+			"and the words like \"obviously\" suggest the reader should already know the topic.",
+		bad:     "// Close simply closes the file. Note that it's safe to call it twice.\nfunc (f *File) Close() error",
+		good:    "// Close closes the file. It's safe to call it twice.\nfunc (f *File) Close() error",
+		disable: `remove "filler": {"enabled": true} from the config`,
+	},
+	"spelling": {
+		rationale: "Mixed spelling variants look careless, and they make the docs harder to search: " +
+			"a search for \"canceled\" doesn't find \"cancelled\".",
+		bad:     "// Cancel cancels the request.\n//\n// The cancelled requests are reported to the behaviour hooks.\nfunc Cancel(r *Request)",
+		good:    "// Cancel cancels the request.\n//\n// The canceled requests are reported to the behavior hooks.\nfunc Cancel(r *Request)",
+		disable: `remove "spelling" from the config`,
+	},
+	"wrap": {
+		rationale: "Long lines are hard to read in the editors and in the go doc output. " +
+			"The -fix reflows the prose paragraphs, keeping the code blocks, lists, headings, " +
+			"directives and the Deprecated paragraphs intact.",
+		disable: `remove "wrap_width" from the config`,
+	},
+	"deprecated-replacement": {
+		rationale: "A notice without a replacement makes every user ask the maintainers what to migrate to. " +
+			"If there is nothing to use instead, saying so answers the question too.",
+		bad:  "// Deprecated: do not use.",
+		good: "// Deprecated: Use [ParseFile] instead.",
+	},
+	"error-doc": {
+		rationale: "The errors are a part of the library contract: the callers need to know " +
+			"when the function fails and which sentinel errors to check for with errors.Is.",
+		bad:     "// Open opens the named file.\nfunc Open(name string) (*File, error)",
+		good:    "// Open opens the named file. It fails with ErrNotExist if the file doesn't exist.\nfunc Open(name string) (*File, error)",
+		disable: `remove "error_docs": true from the config`,
+	},
+	"error-value-doc": {
+		rationale: "The sentinel errors and error types are a part of the API contract: " +
+			"the callers compare against them, so they need to know when each one is returned.",
+		bad:  "// ErrClosed error.\nvar ErrClosed = errors.New(\"closed\")",
+		good: "// ErrClosed is returned when the connection is used after Close.\nvar ErrClosed = errors.New(\"closed\")",
+	},
+	"import-comment": {
+		rationale: "The go command ignores the misplaced import comments and rejects the packages " +
+			"with the conflicting ones. In GOPATH mode, a wrong path breaks the builds of the importers.",
+		bad:  "package foo\n\n// import \"example.com/foo\"",
+		good: "package foo // import \"example.com/foo\"",
+	},
+	"license-header": {
+		rationale: "A comment right above the package clause is the package doc, so a license header " +
+			"without an empty line after it shows up in go doc and hides the real doc.",
+		bad:  "// Copyright 2024 The Authors. All rights reserved.\n// Package foo parses foos.\npackage foo",
+		good: "// Copyright 2024 The Authors. All rights reserved.\n\n// Package foo parses foos.\npackage foo",
+	},
+	"build-constraint": {
+		rationale: "The go command reads the build constraints only from the file header that ends with " +
+			"an empty line before the package clause. A constraint glued to the package doc or the package " +
+			"clause is ignored, so the file is built everywhere, and the constraint shows up in the docs.",
+		bad:  "//go:build linux\n// Package epoll wraps the epoll API.\npackage epoll",
+		good: "//go:build linux\n\n// Package epoll wraps the epoll API.\npackage epoll",
+	},
+	"duplicate-doc": {
+		rationale: "Copied doc-comments are often not updated for the declaration they were pasted to.",
+		disable:   `set "duplicate_doc_similarity" to 0`,
+	},
+	"double-space": {
+		rationale: "Double spaces are usually left by copy-pasting or joining the lines, and they show up " +
+			"in the go doc output and the diffs. The -fix replaces them with a single space.",
+		bad:     "// Parse parses  the config.\nfunc Parse() error",
+		good:    "// Parse parses the config.\nfunc Parse() error",
+		disable: `set "sentence_spacing": "double" to keep two spaces after the sentences`,
+	},
+	"repeated-word": {
+		rationale: "A repeated word is a typo that is easy to miss, especially when the repeat " +
+			"is wrapped to the next line. The -fix removes the repeats on the same line. " +
+			"The correct repeats like \"had had\" and the words of the .doccheck-words files are accepted.",
+		bad:  "// Close closes the the file.\nfunc (f *File) Close() error",
+		good: "// Close closes the file.\nfunc (f *File) Close() error",
+	},
+	"sentence-case": {
+		rationale: "A lower case sentence is usually appended to the doc later without a review. " +
+			"The sentences that start with the identifiers, like the parameter and the declared names, " +
+			"are not reported, and the abbreviations like \"e.g.\" don't end the sentences.",
+		bad:     "// Parse parses the config. it fails on the empty input.\nfunc Parse(s string) error",
+		good:    "// Parse parses the config. It fails on the empty input.\nfunc Parse(s string) error",
+		disable: `extend the abbreviations that don't end the sentences with "abbreviations": ["approx."]`,
+	},
+}
+
+// explain prints the check documentation. The check is
+// identified either by its name or by its ID. The texts
+// are translated with the catalog, if there is one.
+func (l *linter) explain(w io.Writer, check string, cat *catalog) error {
+	info := findCheck(check)
+	if info == nil {
+		if registered := findRegistered(registeredChecks(), check); registered != nil {
+			fmt.Fprintf(w, "%s (%s)\n\n%s\n", registered.Name(), severityWarning, registered.Description())
+			fmt.Fprintf(w, "\n%s\n", cat.sprintf("It's a registered third-party check, see its package docs for the details."))
+			return nil
+		}
+		return fmt.Errorf("unknown check %q, see doccheck checks", check)
+	}
+	e := cat.explanation(info.name)
+	fmt.Fprintf(w, "%s %s (%s)\n\n", info.id, info.name, info.severity)
+	fmt.Fprintf(w, "%s\n", cat.description(info))
+	if e.rationale != "" {
+		fmt.Fprintf(w, "\n%s\n", e.rationale)
+	}
+	if e.bad != "" {
+		fmt.Fprintf(w, "\n%s\n\n%s\n", cat.sprintf("Bad:"), indentExample(e.bad))
+	}
+	if e.good != "" {
+		fmt.Fprintf(w, "\n%s\n\n%s\n", cat.sprintf("Good:"), indentExample(e.good))
+	}
+	fmt.Fprintf(w, "\n%s\n", cat.sprintf("To suppress the findings of a declaration, add //nolint:doccheck or //nolint:%s\n"+
+		"to its doc-comment. To keep the findings from failing the run, lower the severity\n"+
+		"below -fail-on, e.g. -severity=%s=info -fail-on=warning, or use -base to report\n"+
+		"only the findings in the changed code.", info.name, info.id))
+	if e.disable != "" {
+		fmt.Fprintf(w, "%s\n", cat.sprintf("To disable it, %s.", e.disable))
+	}
+	return nil
+}
+
+func indentExample(s string) string {
+	return "\t" + strings.ReplaceAll(s, "\n", "\n\t")
+}
@@ -0,0 +1,60 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// defaultFillerWords are the words and phrases that add nothing
+// to the docs or imply the reader should already know the topic.
+var defaultFillerWords = []string{
+	"simply",
+	"just",
+	"obviously",
+	"clearly",
+	"easily",
+	"basically",
+	"of course",
+	"note that",
+	"it should be noted that",
+	"needless to say",
+}
+
+// compileFillerWords returns the regexp matching any of the words
+// as whole words, ignoring the case, or nil if the list is empty.
+func compileFillerWords(words []string) *regexp.Regexp {
+	var patterns []string
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			patterns = append(patterns, strings.Join(strings.Fields(regexp.QuoteMeta(w)), `\s+`))
+		}
+	}
+	if len(patterns) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(patterns, "|") + `)\b`)
+}
+
+// checkFillerWords reports the configured filler words in the doc prose.
+// Code blocks and the double-quoted text are not checked.
+func (c *checker) checkFillerWords(doc *ast.CommentGroup) {
+	if c.fillerWords == nil {
+		return
+	}
+	for _, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) || isIndented(strings.TrimPrefix(text, " ")) {
+			continue
+		}
+		text = doubleQuotedRegexp.ReplaceAllStringFunc(text, func(s string) string {
+			return strings.Repeat(" ", len(s))
+		})
+		for _, word := range c.fillerWords.FindAllString(text, -1) {
+			if c.words.Has(word) {
+				continue
+			}
+			c.warnComment("filler-word", comment, "%q is a filler word, remove it or rephrase the sentence", strings.ToLower(word))
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"os"
+	"sort"
+)
+
+// textEdit replaces [start, end) byte range of the file with newText.
+type textEdit struct {
+	check    string
+	filename string
+	start    int
+	end      int
+	newText  string
+}
+
+// appliedEdit is a fix report entry.
+type appliedEdit struct {
+	File   string       `json:"file"`
+	Check  string       `json:"check"`
+	Start  editPosition `json:"start"`
+	End    editPosition `json:"end"`
+	Before string       `json:"before"`
+	After  string       `json:"after"`
+}
+
+type editPosition struct {
+	Offset int `json:"offset"`
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// suggestFix attaches the edit to the next reported issue.
+// With -fix, the edit is also applied to the file.
+func (c *checker) suggestFix(e textEdit) {
+	c.current.fixes = append(c.current.fixes, e)
+}
+
+// suggestedFix returns the edit positions resolved against
+// the file source. Only the offsets are set if it's not loaded.
+func (c *checker) suggestedFix(e textEdit) SuggestedFix {
+	fix := SuggestedFix{
+		Pos:     token.Position{Filename: e.filename, Offset: e.start},
+		End:     token.Position{Filename: e.filename, Offset: e.end},
+		NewText: e.newText,
+	}
+	if src, ok := c.sources[e.filename]; ok {
+		start, end := offsetPosition(src, e.start), offsetPosition(src, e.end)
+		fix.Pos.Line, fix.Pos.Column = start.Line, start.Column
+		fix.End.Line, fix.End.Column = end.Line, end.Column
+	}
+	return fix
+}
+
+func (l *linter) addFix(e textEdit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.edits = append(l.edits, e)
+}
+
+// ApplyFixes writes all collected edits to the files
+// and returns the edits that were applied.
+//
+// Edits that overlap with an already applied edit are skipped,
+// they can be applied by the next run.
+func (l *linter) ApplyFixes() ([]appliedEdit, error) {
+	byFile := make(map[string][]textEdit)
+	var filenames []string
+	for _, e := range l.edits {
+		if byFile[e.filename] == nil {
+			filenames = append(filenames, e.filename)
+		}
+		byFile[e.filename] = append(byFile[e.filename], e)
+	}
+	sort.Strings(filenames)
+
+	var applied []appliedEdit
+	for _, filename := range filenames {
+		edits := byFile[filename]
+		info, err := os.Stat(filename)
+		if err != nil {
+			return applied, err
+		}
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return applied, err
+		}
+
+		sort.SliceStable(edits, func(i, j int) bool {
+			return edits[i].start < edits[j].start
+		})
+		var buf bytes.Buffer
+		var fileApplied []appliedEdit
+		offset := 0
+		for _, e := range edits {
+			if e.start < offset {
+				continue
+			}
+			buf.Write(src[offset:e.start])
+			buf.WriteString(e.newText)
+			offset = e.end
+			fileApplied = append(fileApplied, appliedEdit{
+				File:   filename,
+				Check:  e.check,
+				Start:  offsetPosition(src, e.start),
+				End:    offsetPosition(src, e.end),
+				Before: string(src[e.start:e.end]),
+				After:  e.newText,
+			})
+		}
+		buf.Write(src[offset:])
+
+		if err := os.WriteFile(filename, buf.Bytes(), info.Mode()); err != nil {
+			return applied, err
+		}
+		applied = append(applied, fileApplied...)
+	}
+
+	return applied, nil
+}
+
+// writeFixReport writes the applied edits as JSON to the given file.
+func writeFixReport(filename string, applied []appliedEdit) error {
+	if applied == nil {
+		applied = []appliedEdit{}
+	}
+	data, err := json.MarshalIndent(applied, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+// offsetPosition converts src byte offset to 1-based line and column.
+func offsetPosition(src []byte, offset int) editPosition {
+	before := src[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := offset - (bytes.LastIndexByte(before, '\n') + 1) + 1
+	return editPosition{Offset: offset, Line: line, Column: column}
+}
@@ -0,0 +1,77 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFixReportedOnly(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"CODEOWNERS": "a.go @team\n",
+		"a.go": `// Package p is a test.
+package p
+
+// A is ok
+func A() {}
+
+// K is kept
+//
+//nolint:doccheck
+func K() {}
+`,
+		"b.go": "package p\n\n// B is ok\nfunc B() {}\n",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	co, err := findCodeOwners(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reported := 0
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		fix:                        true,
+		keepSuppressed:             true,
+		codeOwners:                 co,
+		ownedBy:                    "@team",
+		stats:                      newRunStats(),
+	}
+	l.sink = func(iss issue, sev severity) {
+		if filepath.Base(iss.Pos.Filename) != "a.go" {
+			t.Errorf("%s is reported for the file that is not owned", iss.Check)
+		}
+		if iss.Suppression == nil {
+			reported++
+		}
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	l.checkDir(dir)
+
+	applied, err := l.ApplyFixes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range applied {
+		if filepath.Base(e.File) != "a.go" || e.Start.Line != 4 {
+			t.Errorf("%s:%d is fixed, want only the a.go:4 fix", filepath.Base(e.File), e.Start.Line)
+		}
+	}
+	if len(applied) == 0 {
+		t.Errorf("a.go:4 is not fixed")
+	}
+	if l.stats.issues != reported {
+		t.Errorf("stats total is %d, want the %d reported unsuppressed issues", l.stats.issues, reported)
+	}
+}
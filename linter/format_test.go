@@ -0,0 +1,106 @@
+package linter
+
+import (
+	"bytes"
+	"flag"
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the output formats")
+
+// checkFormatFixture checks the testdata/format fixture package
+// and prints the issues with the printer that newPrinter returns.
+func checkFormatFixture(t *testing.T, newPrinter func(l *linter) issuePrinter) *linter {
+	t.Helper()
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		concurrency:                1,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	l.printer = newPrinter(l)
+	l.Run([]string{"testdata/format/p"})
+	l.Flush()
+	return l
+}
+
+// checkGolden compares the output with the testdata/format
+// golden file, or updates the file with the -update flag.
+func checkGolden(t *testing.T, name string, have []byte) {
+	t.Helper()
+	filename := filepath.Join("testdata", "format", name)
+	if *update {
+		if err := os.WriteFile(filename, have, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(have, want) {
+		t.Errorf("%s output differs from %s, run the tests with -update to accept it:\nhave:\n%s\nwant:\n%s", name, filename, have, want)
+	}
+}
+
+func TestGitHubFormat(t *testing.T) {
+	var out bytes.Buffer
+	checkFormatFixture(t, func(*linter) issuePrinter {
+		return &githubPrinter{w: &out}
+	})
+	checkGolden(t, "github.golden", out.Bytes())
+}
+
+func TestCodeClimateFormat(t *testing.T) {
+	collected := &issueCollector{}
+	checkFormatFixture(t, func(*linter) issuePrinter {
+		return collected
+	})
+	var out bytes.Buffer
+	if err := writeCodeClimateReport(&out, collected); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "codeclimate.golden", out.Bytes())
+}
+
+func TestRDJSONFormat(t *testing.T) {
+	collected := &issueCollector{}
+	checkFormatFixture(t, func(*linter) issuePrinter {
+		return collected
+	})
+	var out bytes.Buffer
+	if err := writeRDJSONReport(&out, collected); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "rdjson.golden", out.Bytes())
+}
+
+func TestTeamCityFormat(t *testing.T) {
+	var out bytes.Buffer
+	checkFormatFixture(t, func(l *linter) issuePrinter {
+		return &teamcityPrinter{w: &out, l: l}
+	})
+	checkGolden(t, "teamcity.golden", out.Bytes())
+}
+
+func TestHTMLFormat(t *testing.T) {
+	var collected *htmlCollector
+	l := checkFormatFixture(t, func(l *linter) issuePrinter {
+		collected = l.collectHTML()
+		return nil
+	})
+	var out bytes.Buffer
+	if err := l.writeHTMLReport(&out, collected); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "html.golden", out.Bytes())
+}
@@ -0,0 +1,76 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// checkTypeParamDocs reports the docs of the generic functions and
+// types that don't mention some of their type parameters.
+// Only the declarations with at least typeParamDocs type parameters
+// are checked: these are hard to use without knowing what every
+// parameter stands for. Zero typeParamDocs disables the check.
+func (c *checker) checkTypeParamDocs(f *ast.File) {
+	if c.typeParamDocs <= 0 {
+		return
+	}
+	filename := c.fset.Position(f.Pos()).Filename
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !isTestFunc(filename, decl) {
+				c.checkTypeParamsMentioned(funcDeclDoc(decl), decl.Type.TypeParams)
+			}
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				doc := spec.Doc
+				if doc == nil && len(decl.Specs) == 1 {
+					doc = decl.Doc
+				}
+				c.checkTypeParamsMentioned(declDoc{doc: doc, name: spec.Name.Name}, spec.TypeParams)
+			}
+		}
+	}
+}
+
+func (c *checker) checkTypeParamsMentioned(d declDoc, params *ast.FieldList) {
+	if d.doc == nil || params.NumFields() < c.typeParamDocs || !c.isCheckedDecl(d) {
+		return
+	}
+	words := wordRegexp.FindAllString(d.doc.Text(), -1)
+	var missing []string
+	for _, name := range typeParamNames(params) {
+		if name != "_" && !slices.Contains(words, name) {
+			missing = append(missing, name)
+		}
+	}
+	switch len(missing) {
+	case 0:
+	case 1:
+		c.warnGroup("type-param-doc", d.doc, "doc-comment of %s should describe the %s type parameter",
+			d.name, missing[0])
+	default:
+		c.warnGroup("type-param-doc", d.doc, "doc-comment of %s should describe the %s type parameters",
+			d.name, strings.Join(missing, ", "))
+	}
+}
+
+// typeParamNames returns the names of the type parameters.
+func typeParamNames(params *ast.FieldList) []string {
+	if params == nil {
+		return nil
+	}
+	var names []string
+	for _, field := range params.List {
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+	}
+	return names
+}
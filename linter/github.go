@@ -0,0 +1,48 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// githubPrinter writes the issues as the GitHub Actions workflow
+// commands, which the runner turns into the inline annotations:
+//
+//	::warning file=file.go,line=10,col=1,title=DC001 check::message
+type githubPrinter struct {
+	w io.Writer
+}
+
+// githubLevels maps the severities to the annotation commands.
+var githubLevels = [...]string{
+	severityInfo:    "notice",
+	severityWarning: "warning",
+	severityError:   "error",
+}
+
+// Print writes a single annotation command.
+func (p *githubPrinter) Print(d Diagnostic) {
+	props := []string{"file=" + githubEscape(d.Pos.Filename, true)}
+	if d.Pos.Line != 0 {
+		props = append(props, fmt.Sprintf("line=%d", d.Pos.Line), fmt.Sprintf("col=%d", d.Pos.Column))
+	}
+	if d.End.Line != 0 {
+		props = append(props, fmt.Sprintf("endLine=%d", d.End.Line))
+		if d.End.Line == d.Pos.Line {
+			// The end column is only permitted for the single line ranges.
+			props = append(props, fmt.Sprintf("endColumn=%d", d.End.Column))
+		}
+	}
+	props = append(props, "title="+githubEscape(checkLabel(d.Check), true))
+	fmt.Fprintf(p.w, "::%s %s::%s\n", githubLevels[d.severity()], strings.Join(props, ","), githubEscape(d.Message, false))
+}
+
+// githubEscape escapes the workflow command data or property value.
+func githubEscape(s string, property bool) string {
+	s = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+	if property {
+		s = strings.NewReplacer(":", "%3A", ",", "%2C").Replace(s)
+	}
+	return s
+}
@@ -0,0 +1,231 @@
+package linter
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// htmlReport is a standalone -format=html report data.
+type htmlReport struct {
+	Total    int
+	Checks   []htmlCheck
+	Packages []htmlPackage
+}
+
+type htmlCheck struct {
+	Name        string
+	Severity    string
+	Description string
+	Issues      int
+}
+
+type htmlPackage struct {
+	Path  string
+	Files []htmlFile
+}
+
+type htmlFile struct {
+	Name   string
+	Issues []htmlIssue
+}
+
+type htmlIssue struct {
+	Line     int
+	Column   int
+	Severity string
+	Check    string
+	Message  string
+	Excerpt  []excerptLine
+}
+
+// excerptLine is a source line split around the highlighted part.
+type excerptLine struct {
+	Number int
+	Before string
+	Marked string
+	After  string
+}
+
+// maxExcerptLines limits the source excerpt length of a single issue.
+const maxExcerptLines = 8
+
+// htmlCollector accumulates the reported issues for the HTML report.
+type htmlCollector struct {
+	issues []issue
+	sevs   []severity
+
+	// sources caches the file contents for the excerpts.
+	sources map[string][]byte
+}
+
+func (l *linter) collectHTML() *htmlCollector {
+	c := &htmlCollector{sources: make(map[string][]byte)}
+	l.sink = func(iss issue, sev severity) {
+		c.issues = append(c.issues, iss)
+		c.sevs = append(c.sevs, sev)
+	}
+	return c
+}
+
+// writeHTMLReport writes the report for the collected issues.
+func (l *linter) writeHTMLReport(w io.Writer, c *htmlCollector) error {
+	report := htmlReport{Total: len(c.issues)}
+
+	counts := make(map[string]int)
+	packages := make(map[string]map[string][]htmlIssue)
+	for i, iss := range c.issues {
+		counts[iss.Check]++
+		dir := filepath.Dir(iss.Pos.Filename)
+		file := iss.Pos.Filename
+		if iss.Pos.Line == 0 && !strings.HasSuffix(file, ".go") {
+			// Package-level findings are reported for the directory.
+			dir = file
+			file = ""
+		}
+		if packages[dir] == nil {
+			packages[dir] = make(map[string][]htmlIssue)
+		}
+		packages[dir][file] = append(packages[dir][file], htmlIssue{
+			Line:     iss.Pos.Line,
+			Column:   iss.Pos.Column,
+			Severity: c.sevs[i].String(),
+			Check:    iss.Check,
+			Message:  iss.Message,
+			Excerpt:  c.excerpt(iss),
+		})
+	}
+
+	for _, name := range l.checkNames() {
+		if counts[name] == 0 {
+			continue
+		}
+		report.Checks = append(report.Checks, htmlCheck{
+			Name:        name,
+			Severity:    l.severities[name].String(),
+			Description: l.checkDescription(name),
+			Issues:      counts[name],
+		})
+	}
+
+	for _, dir := range sortedKeys(packages) {
+		pkg := htmlPackage{Path: dir}
+		for _, file := range sortedKeys(packages[dir]) {
+			issues := packages[dir][file]
+			sort.SliceStable(issues, func(i, j int) bool {
+				return issues[i].Line < issues[j].Line
+			})
+			pkg.Files = append(pkg.Files, htmlFile{Name: file, Issues: issues})
+		}
+		report.Packages = append(report.Packages, pkg)
+	}
+
+	return htmlReportTemplate.Execute(w, report)
+}
+
+// excerpt returns the source lines of the issue with its range highlighted.
+// Issues without a range highlight the reported line from the column.
+func (c *htmlCollector) excerpt(iss issue) []excerptLine {
+	if iss.Pos.Line == 0 {
+		return nil
+	}
+	src, ok := c.sources[iss.Pos.Filename]
+	if !ok {
+		src, _ = os.ReadFile(iss.Pos.Filename)
+		c.sources[iss.Pos.Filename] = src
+	}
+	lines := strings.Split(string(src), "\n")
+	if iss.Pos.Line > len(lines) {
+		return nil
+	}
+
+	endLine, endColumn := iss.Pos.Line, 0
+	if iss.End.Line != 0 {
+		endLine, endColumn = iss.End.Line, iss.End.Column
+	}
+	endLine = min(endLine, len(lines), iss.Pos.Line+maxExcerptLines-1)
+
+	var excerpt []excerptLine
+	for n := iss.Pos.Line; n <= endLine; n++ {
+		line := lines[n-1]
+		from, to := 0, len(line)
+		if n == iss.Pos.Line {
+			from = min(max(iss.Pos.Column-1, 0), len(line))
+		}
+		if n == iss.End.Line && endColumn != 0 {
+			to = min(max(endColumn-1, from), len(line))
+		}
+		excerpt = append(excerpt, excerptLine{
+			Number: n,
+			Before: line[:from],
+			Marked: line[from:to],
+			After:  line[to:],
+		})
+	}
+	return excerpt
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>doccheck report</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 0.2em 0.8em; text-align: left; vertical-align: top; }
+pre { background: #f6f8fa; padding: 0.5em; overflow-x: auto; }
+mark { background: #ffd33d; }
+.lineno { color: #888; user-select: none; }
+.error { color: #b31d28; }
+.warning { color: #b08800; }
+.info { color: #0366d6; }
+</style>
+</head>
+<body>
+<h1>doccheck report</h1>
+<p>{{.Total}} issues.</p>
+{{- if .Checks}}
+<h2>Checks</h2>
+<table>
+<tr><th>Check</th><th>Severity</th><th>Issues</th><th>Description</th></tr>
+{{- range .Checks}}
+<tr><td><a href="#check-{{.Name}}" id="check-{{.Name}}">{{.Name}}</a></td><td class="{{.Severity}}">{{.Severity}}</td><td>{{.Issues}}</td><td>{{.Description}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- range .Packages}}
+<h2>{{.Path}}</h2>
+{{- range .Files}}
+{{- if .Name}}
+<h3>{{.Name}}</h3>
+{{- end}}
+{{- range .Issues}}
+<p><span class="{{.Severity}}">{{.Severity}}</span>
+{{- if .Line}} line {{.Line}}:{{.Column}}{{end}}
+<a href="#check-{{.Check}}">{{.Check}}</a>: {{.Message}}</p>
+{{- if .Excerpt}}
+<pre>
+{{- range .Excerpt}}
+<span class="lineno">{{printf "%4d" .Number}}</span> {{.Before}}<mark>{{.Marked}}</mark>{{.After}}
+{{- end}}
+</pre>
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
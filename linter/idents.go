@@ -0,0 +1,161 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// identStyle is a way to refer to an identifier in doc-comment prose.
+type identStyle int
+
+const (
+	identPlain  identStyle = iota // the Options struct
+	identQuoted                   // the "Options" struct
+	identLink                     // the [Options] struct
+)
+
+var identStyleNames = [...]string{
+	identPlain:  "plain",
+	identQuoted: "quoted",
+	identLink:   "link",
+}
+
+func (s identStyle) String() string { return identStyleNames[s] }
+
+// identRefRegexp matches identifier references of all styles.
+// Exactly one of the groups is matched: link, backquoted,
+// double-quoted or plain name.
+var identRefRegexp = regexp.MustCompile(
+	`\[([A-Za-z_]\w*)(?:\.\w+)?\]|` + "`" + `([A-Za-z_]\w*)` + "`" + `|"([A-Za-z_]\w*)"|\b([A-Za-z_]\w*)\b`)
+
+// identRef is an identifier mention found in a doc-comment.
+type identRef struct {
+	comment *ast.Comment
+	name    string
+	style   identStyle
+
+	// start and end are the mention offsets in the comment text.
+	start int
+	end   int
+}
+
+// checkIdentStyle finds package identifiers mentioned in the
+// doc-comments and checks that all of them are referred to
+// in the same way.
+//
+// In "consistent" mode the style that is used by the majority of
+// quoted and linked mentions wins; plain mentions are not reported
+// as they're a natural part of the prose.
+func (c *checker) checkIdentStyle(pkg *ast.Package) {
+	if c.identStyle == "" {
+		return
+	}
+
+	idents := packageIdents(pkg)
+	docs := collectDeclDocs(pkg)
+
+	var refs []identRef
+	for _, d := range docs {
+		refs = append(refs, collectIdentRefs(d.doc, d.name, idents)...)
+	}
+
+	want := identPlain
+	switch c.identStyle {
+	case "plain":
+		want = identPlain
+	case "quoted":
+		want = identQuoted
+	case "link":
+		want = identLink
+	case "consistent":
+		quoted, linked := 0, 0
+		for _, ref := range refs {
+			switch ref.style {
+			case identQuoted:
+				quoted++
+			case identLink:
+				linked++
+			}
+		}
+		if quoted == 0 || linked == 0 {
+			return
+		}
+		want = identLink
+		if quoted > linked {
+			want = identQuoted
+		}
+	}
+
+	for _, ref := range refs {
+		if ref.style == want {
+			continue
+		}
+		if c.identStyle == "consistent" && ref.style == identPlain {
+			continue
+		}
+		if want == identLink {
+			pos := c.fset.Position(ref.comment.Pos())
+			c.suggestFix(textEdit{
+				check:    "identifier-style",
+				filename: pos.Filename,
+				start:    pos.Offset + ref.start,
+				end:      pos.Offset + ref.end,
+				newText:  "[" + ref.name + "]",
+			})
+		}
+		c.warnComment("identifier-style", ref.comment, "%s is mentioned in %s style, package style is %s",
+			ref.name, ref.style, want)
+	}
+}
+
+// collectIdentRefs returns exported idents mentions from the doc.
+// Mentions of the documented declaration itself are ignored,
+// as well as code blocks and directives.
+func collectIdentRefs(doc *ast.CommentGroup, self string, idents map[string]bool) []identRef {
+	var refs []identRef
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			continue
+		}
+		text := comment.Text
+		body := text[len("//"):]
+		if strings.HasPrefix(body, "\t") || strings.HasPrefix(body, "  ") {
+			// Code block line.
+			continue
+		}
+		if body != "" && body[0] != ' ' {
+			// Directive.
+			continue
+		}
+		for _, m := range identRefRegexp.FindAllStringSubmatchIndex(text, -1) {
+			for group := 1; group <= 4; group++ {
+				from, to := m[2*group], m[2*group+1]
+				if from == -1 {
+					continue
+				}
+				name := text[from:to]
+				if name == self || !idents[name] || !token.IsExported(name) {
+					break
+				}
+				style := identPlain
+				switch group {
+				case 1:
+					style = identLink
+				case 2, 3:
+					style = identQuoted
+				}
+				refs = append(refs, identRef{
+					comment: comment,
+					name:    name,
+					style:   style,
+					start:   m[0],
+					end:     m[1],
+				})
+				break
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,92 @@
+package linter
+
+import (
+	"go/ast"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// importCommentRegexp matches the canonical import path comments,
+// like // import "example.com/foo". The path is either quoted or
+// looks like an import path, so the prose like "import cycles"
+// is not matched.
+var importCommentRegexp = regexp.MustCompile("^(?://|/\\*)\\s*import\\s+(\"[^\"]*\"|`[^`]*`|'[^']*'|[^\\s\"'`]+/[^\\s\"'`]*)\\s*(?:\\*/)?$")
+
+// moduleImportPath returns the import path of the package in the dir
+// according to the go.mod file of the enclosing module,
+// or "" if there is no go.mod file.
+func moduleImportPath(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		if modPath, err := readModulePath(filepath.Join(d, "go.mod")); err == nil {
+			rel, err := filepath.Rel(d, abs)
+			if err != nil {
+				return ""
+			}
+			if rel == "." {
+				return modPath
+			}
+			return modPath + "/" + filepath.ToSlash(rel)
+		}
+		if parent := filepath.Dir(d); parent == d {
+			return ""
+		}
+	}
+}
+
+// checkImportComments checks the canonical import path comments of
+// the package files: the comment follows the package clause on the
+// same line, the path is double-quoted, matches the one of the other
+// files and the module import path, if it's known.
+//
+// The go command rejects the packages with the conflicting comments,
+// and the misplaced ones are silently ignored.
+func (c *checker) checkImportComments(pkg *ast.Package) {
+	if strings.HasSuffix(pkg.Name, "_test") {
+		return
+	}
+	var first *ast.Comment
+	var firstPath, firstFile string
+	for _, filename := range sortedKeys(pkg.Files) {
+		f := pkg.Files[filename]
+		pkgLine := c.fset.Position(f.Package).Line
+		for _, group := range f.Comments {
+			line := c.fset.Position(group.Pos()).Line
+			if line > pkgLine+1 {
+				break
+			}
+			for _, comment := range group.List {
+				m := importCommentRegexp.FindStringSubmatch(comment.Text)
+				if m == nil {
+					continue
+				}
+				if comment.Pos() < f.Name.End() || c.fset.Position(comment.Pos()).Line != pkgLine {
+					c.warnComment("import-comment", comment, "import comment is ignored, it must follow the package clause on the same line")
+					continue
+				}
+				path, err := strconv.Unquote(m[1])
+				if err != nil || m[1][0] == '\'' {
+					c.warnComment("import-comment", comment, "import comment path should be double-quoted, like // import %q", strings.Trim(m[1], `'`))
+					continue
+				}
+				switch {
+				case first == nil:
+					first, firstPath, firstFile = comment, path, filename
+				case path != firstPath:
+					c.warnComment("import-comment", comment, "import comment %q conflicts with %q in %s", path, firstPath, filepath.Base(firstFile))
+					continue
+				default:
+					continue
+				}
+				if c.importPath != "" && path != c.importPath {
+					c.warnComment("import-comment", comment, "import comment %q doesn't match the module import path %q", path, c.importPath)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// defaultInitialisms are the initialisms that Go code spells in
+// a consistent case, like URL or url, but never in the mixed case.
+var defaultInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "GID", "UID",
+	"UUID", "URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+var (
+	// proseWordRegexp matches the doc words that may be identifiers.
+	proseWordRegexp = regexp.MustCompile(`\b[A-Za-z][A-Za-z0-9]*\b`)
+
+	doubleQuotedRegexp = regexp.MustCompile(`"[^"]*"`)
+)
+
+// newInitialisms returns the set of the default and the extra initialisms.
+func newInitialisms(extra []string) map[string]bool {
+	set := make(map[string]bool, len(defaultInitialisms)+len(extra))
+	for _, s := range defaultInitialisms {
+		set[s] = true
+	}
+	for _, s := range extra {
+		set[strings.ToUpper(s)] = true
+	}
+	return set
+}
+
+// checkInitialisms reports the doc words that spell the initialisms
+// in the mixed case, like "Url" or "Id", and the mentions of the
+// declared identifiers with the initialisms spelled differently,
+// like ServeHttp when the package declares ServeHTTP.
+//
+// The opening name of the doc is checked by the name-typo check,
+// and the double-quoted words are the mentions of the words themselves.
+func (c *checker) checkInitialisms(doc *ast.CommentGroup) {
+	for i, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) {
+			continue
+		}
+		if isIndented(strings.TrimPrefix(text, " ")) {
+			continue
+		}
+		blank := func(s string) string { return strings.Repeat(" ", len(s)) }
+		text = urlRegexp.ReplaceAllStringFunc(text, blank)
+		text = doubleQuotedRegexp.ReplaceAllStringFunc(text, blank)
+
+		reported := make(map[string]bool)
+		for j, loc := range proseWordRegexp.FindAllStringIndex(text, -1) {
+			word := text[loc[0]:loc[1]]
+			if i == 0 && j == 0 || c.declared[word] || reported[word] || c.words.Has(word) {
+				continue
+			}
+			fixed := fixInitialisms(word, c.initialisms)
+			if fixed == word {
+				continue
+			}
+			if !c.declared[fixed] && fixed != strings.ToUpper(word) {
+				// Likely an identifier of some other package.
+				continue
+			}
+			reported[word] = true
+			pos := c.fset.Position(comment.Pos())
+			offset := pos.Offset + len("//") + loc[0]
+			c.suggestFix(textEdit{
+				check:    "initialism",
+				filename: pos.Filename,
+				start:    offset,
+				end:      offset + len(word),
+				newText:  fixed,
+			})
+			if c.declared[fixed] {
+				c.warnComment("initialism", comment, "%s doesn't match the declared name %s", word, fixed)
+			} else {
+				c.warnComment("initialism", comment, "%s is an initialism, spell it as %s", word, fixed)
+			}
+		}
+	}
+}
+
+// fixInitialisms returns the word with the mixed-case initialisms
+// of its parts in the upper case. A lower case first part is
+// kept as is, since it starts an unexported name.
+func fixInitialisms(word string, initialisms map[string]bool) string {
+	parts := camelParts(word)
+	for i, part := range parts {
+		upper := strings.ToUpper(part)
+		if part == upper || !initialisms[upper] {
+			continue
+		}
+		if i == 0 && part == strings.ToLower(part) {
+			continue
+		}
+		parts[i] = upper
+	}
+	return strings.Join(parts, "")
+}
+
+// camelParts splits the word into its parts at the case changes.
+// The digits belong to the preceding part, so "UTF8" and "Utf8"
+// are single parts.
+func camelParts(word string) []string {
+	runes := []rune(word)
+	var parts []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		switch {
+		case unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]):
+			// fooBar and Utf8Path are split before the B and P.
+			parts = append(parts, string(runes[start:i]))
+			start = i
+		case unicode.IsLower(runes[i]) && unicode.IsUpper(runes[i-1]) && i-1 > start:
+			// HTTPServer is split before the S.
+			parts = append(parts, string(runes[start:i-1]))
+			start = i - 1
+		}
+	}
+	return append(parts, string(runes[start:]))
+}
@@ -0,0 +1,84 @@
+package linter
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+)
+
+// issueCollector keeps the printed issues for the formats that are
+// written at once after the run, like -format=json and -format=sarif.
+type issueCollector struct {
+	diagnostics []Diagnostic
+}
+
+// Print remembers the issue.
+func (p *issueCollector) Print(d Diagnostic) {
+	p.diagnostics = append(p.diagnostics, d)
+}
+
+// jsonIssue is a -format=json report entry.
+// The end position is omitted for the issues without a range.
+type jsonIssue struct {
+	File      string    `json:"file"`
+	Line      int       `json:"line,omitempty"`
+	Column    int       `json:"column,omitempty"`
+	EndLine   int       `json:"end_line,omitempty"`
+	EndColumn int       `json:"end_column,omitempty"`
+	Check     string    `json:"check"`
+	ID        string    `json:"id,omitempty"`
+	Severity  string    `json:"severity"`
+	Message   string    `json:"message"`
+	Name      string    `json:"name,omitempty"`
+	Fixes     []jsonFix `json:"fixes,omitempty"`
+
+	Suppression *jsonSuppression `json:"suppression,omitempty"`
+}
+
+// jsonSuppression is the //nolint directive that suppresses
+// the -format=json report entry.
+type jsonSuppression struct {
+	Directive     string `json:"directive"`
+	Justification string `json:"justification,omitempty"`
+}
+
+// jsonFix is a suggested fix of the -format=json report entry.
+type jsonFix struct {
+	Start   editPosition `json:"start"`
+	End     editPosition `json:"end"`
+	NewText string       `json:"new_text"`
+}
+
+// writeJSONReport writes the collected issues as a JSON array.
+func writeJSONReport(w io.Writer, c *issueCollector) error {
+	report := make([]jsonIssue, 0, len(c.diagnostics))
+	for _, d := range c.diagnostics {
+		entry := jsonIssue{
+			File:      d.Pos.Filename,
+			Line:      d.Pos.Line,
+			Column:    d.Pos.Column,
+			EndLine:   d.End.Line,
+			EndColumn: d.End.Column,
+			Check:     d.Check,
+			ID:        d.ID,
+			Severity:  d.Severity,
+			Message:   d.Message,
+			Name:      d.Name,
+		}
+		for _, fix := range d.Fixes {
+			entry.Fixes = append(entry.Fixes, jsonFix{Start: jsonPosition(fix.Pos), End: jsonPosition(fix.End), NewText: fix.NewText})
+		}
+		if s := d.Suppression; s != nil {
+			entry.Suppression = &jsonSuppression{Directive: s.Directive, Justification: s.Justification}
+		}
+		report = append(report, entry)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
+
+func jsonPosition(pos token.Position) editPosition {
+	return editPosition{Offset: pos.Offset, Line: pos.Line, Column: pos.Column}
+}
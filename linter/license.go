@@ -0,0 +1,81 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// licenseRegexp matches the first line of the copyright and license headers.
+var licenseRegexp = regexp.MustCompile(`(?i)^(?:copyright\b|\(c\)|©|spdx-license-identifier:|licensed under\b|use of this source code is governed\b|this (?:file|program|source code) is (?:free software|licensed|part of)\b)`)
+
+// licenseDocStart returns the index of the doc line where the
+// real package doc starts after the license header, len(doc.List)
+// if the doc is only the license, and 0 if there is no license.
+//
+// The real doc is found by its "Package name" or "Command" opening.
+func licenseDocStart(f *ast.File) int {
+	if !licenseRegexp.MatchString(commentLine(f.Doc.List[0])) {
+		return 0
+	}
+	for i, comment := range f.Doc.List {
+		text := commentLine(comment)
+		if strings.HasPrefix(text, "Package "+f.Name.Name) || strings.HasPrefix(text, "Command ") {
+			return i
+		}
+	}
+	return len(f.Doc.List)
+}
+
+// commentLine returns the comment text without the markers
+// and the surrounding spaces.
+func commentLine(comment *ast.Comment) string {
+	text := strings.TrimPrefix(comment.Text, "//")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	return strings.TrimSpace(text)
+}
+
+// packageDoc returns the package doc of the file without
+// the license header, or nil if there is no doc.
+func packageDoc(f *ast.File) *ast.CommentGroup {
+	if f.Doc == nil {
+		return nil
+	}
+	start := licenseDocStart(f)
+	if start == len(f.Doc.List) {
+		return nil
+	}
+	if start == 0 {
+		return f.Doc
+	}
+	return &ast.CommentGroup{List: f.Doc.List[start:]}
+}
+
+// checkLicenseHeader reports the license headers that are a part of
+// the package doc: the ones that are not separated from the package
+// clause, or from the real doc, by an empty line. The fix inserts it.
+func (c *checker) checkLicenseHeader(f *ast.File) {
+	doc := f.Doc
+	start := licenseDocStart(f)
+	if start == 0 || strings.HasPrefix(doc.List[0].Text, "/*") {
+		return
+	}
+
+	if start == len(doc.List) {
+		end := c.fset.Position(doc.End())
+		c.suggestFix(textEdit{check: "license-header", filename: end.Filename, start: end.Offset, end: end.Offset, newText: "\n"})
+		c.warnComment("license-header", doc.List[0], "license header is the package doc, separate it from the package clause with an empty line")
+		return
+	}
+
+	// The paragraph separators between the license and the doc
+	// are replaced with the empty line.
+	last := start - 1
+	for last > 0 && commentLine(doc.List[last]) == "" {
+		last--
+	}
+	from := c.fset.Position(doc.List[last].End())
+	to := c.fset.Position(doc.List[start].Pos())
+	c.suggestFix(textEdit{check: "license-header", filename: from.Filename, start: from.Offset, end: to.Offset, newText: "\n\n"})
+	c.warnComment("license-header", doc.List[0], "license header swallows the package doc, separate them with an empty line")
+}
@@ -0,0 +1,190 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"sort"
+)
+
+// Diagnostic is a single check finding reported by Lint.
+type Diagnostic struct {
+	// Check is the check name and ID is its stable ID, like DC008.
+	// Custom rules from the config have no IDs.
+	Check string
+	ID    string
+
+	// Severity is the check severity: error, warning or info.
+	Severity string
+
+	// Pos is the finding location. Package-level findings
+	// may have only the Filename set.
+	Pos token.Position
+
+	// End is the end of the reported source range, exclusive.
+	// It's unset when the finding is not about a specific range.
+	End token.Position
+
+	Message string
+
+	// Name is the documented identifier, empty if it's unknown.
+	Name string
+
+	// Fixes are the suggested edits that resolve the finding.
+	// Lint only suggests them for the files with Options.Sources.
+	Fixes []SuggestedFix
+
+	// Suppression is the //nolint directive of the declaration
+	// doc-comment that suppresses the finding, nil if it's not
+	// suppressed. Lint returns the suppressed findings too.
+	Suppression *Suppression
+}
+
+// SuggestedFix replaces the [Pos, End) source range with NewText.
+// The line and column positions are unset if the source is unknown.
+type SuggestedFix struct {
+	Pos     token.Position
+	End     token.Position
+	NewText string
+}
+
+// diagnostic returns the reported issue as a Diagnostic.
+func (iss issue) diagnostic(sev severity) Diagnostic {
+	d := Diagnostic{
+		Check:       iss.Check,
+		Severity:    sev.String(),
+		Pos:         iss.Pos,
+		End:         iss.End,
+		Message:     iss.Message,
+		Name:        iss.Name,
+		Fixes:       iss.Fixes,
+		Suppression: iss.Suppression,
+	}
+	if info := findCheck(iss.Check); info != nil {
+		d.ID = info.id
+	}
+	return d
+}
+
+// severity returns the Severity value, info if it's unknown.
+func (d Diagnostic) severity() severity {
+	sev, _ := parseSeverity(d.Severity)
+	return sev
+}
+
+// Reporter receives the diagnostics as soon as they are found.
+// Report is never called concurrently.
+type Reporter interface {
+	Report(d Diagnostic)
+}
+
+// Options configure Lint.
+type Options struct {
+	// Config is the checks configuration, see ParseConfig.
+	// The defaults are used if it's nil.
+	Config *Config
+
+	// Severities are the check=severity overrides, the same
+	// as the -severity flag values, e.g. "DC008=info".
+	Severities []string
+
+	// All enables the checks of the unexported identifiers too.
+	All bool
+
+	// Reporter receives the diagnostics if it's not nil.
+	Reporter Reporter
+
+	// Sources are the file contents by the file names, as in
+	// the file set. With the sources, the fixes are suggested.
+	Sources map[string][]byte
+}
+
+// Lint checks the parsed files and returns the diagnostics sorted by their
+// positions. The files are grouped into packages by the directory and the
+// package name, so the package-level checks see all files of the package.
+//
+// Only the checks that don't need the type information are executed,
+// and no fixes are applied. The files must be parsed with the comments.
+// The .doccheck-words files are looked up by the file names.
+func Lint(fset *token.FileSet, files []*ast.File, opts Options) ([]Diagnostic, error) {
+	return lint(fset, files, opts, true)
+}
+
+// lint is Lint that looks up the module import paths and the words files
+// of the package dirs on disk only with onDisk. The files that don't come
+// from disk, like the embedded selftest corpus, have neither.
+func lint(fset *token.FileSet, files []*ast.File, opts Options, onDisk bool) ([]Diagnostic, error) {
+	l := &linter{
+		fset:                       fset,
+		maxFirstParagraphSentences: 3,
+		exportedOnly:               !opts.All,
+		keepSuppressed:             true,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	conf := opts.Config
+	if conf == nil {
+		conf = defaultConfig()
+	}
+	if err := l.applyConfig(conf, opts.Severities); err != nil {
+		return nil, err
+	}
+
+	var diagnostics []Diagnostic
+	l.sink = func(iss issue, sev severity) {
+		d := iss.diagnostic(sev)
+		if opts.Reporter != nil {
+			opts.Reporter.Report(d)
+		}
+		diagnostics = append(diagnostics, d)
+	}
+
+	type packageKey struct{ dir, name string }
+	packages := make(map[packageKey]*ast.Package)
+	var keys []packageKey
+	for _, f := range files {
+		filename := fset.Position(f.Package).Filename
+		key := packageKey{dir: filepath.Dir(filename), name: f.Name.Name}
+		pkg := packages[key]
+		if pkg == nil {
+			pkg = &ast.Package{Name: key.name, Files: make(map[string]*ast.File)}
+			packages[key] = pkg
+			keys = append(keys, key)
+		}
+		pkg.Files[filename] = f
+	}
+
+	for _, key := range keys {
+		pkg := packages[key]
+		c := &checker{
+			linter:   l,
+			settings: l.settings,
+			path:     key.dir,
+			sources:  opts.Sources,
+		}
+		if onDisk {
+			c.words = l.dirWords(key.dir)
+			c.importPath = moduleImportPath(key.dir)
+		}
+		pkgFiles := sortedFiles(pkg)
+		c.declared = declaredNames(pkgFiles)
+		c.nolint = collectNolint(fset, pkgFiles)
+		c.runPackageChecks(pkg)
+		for _, f := range pkgFiles {
+			c.CheckFile(f)
+		}
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		x, y := diagnostics[i].Pos, diagnostics[j].Pos
+		if x.Filename != y.Filename {
+			return x.Filename < y.Filename
+		}
+		if x.Line != y.Line {
+			return x.Line < y.Line
+		}
+		return x.Column < y.Column
+	})
+	return diagnostics, nil
+}
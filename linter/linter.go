@@ -0,0 +1,1923 @@
+package linter
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	godoc "go/doc"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Main runs the doccheck command with the given command-line arguments.
+func Main(args []string) {
+	l := &linter{
+		fset:             token.NewFileSet(),
+		printer:          &textPrinter{w: os.Stderr},
+		languageOverride: os.Getenv("DOCCHECK_LANG"),
+	}
+
+	subcommand := ""
+	if len(args) != 0 {
+		switch args[0] {
+		case "fix", "audit", "changed", "checks", "selftest":
+			subcommand = args[0]
+			args = args[1:]
+		case "explain":
+			if len(args) != 2 {
+				log.Fatalf("usage: doccheck explain <check name or ID>")
+			}
+			conf, err := l.dirConfig(".")
+			if err != nil {
+				log.Fatalf("explain: %v", err)
+			}
+			cat, err := loadCatalog(l.withLanguage(conf).Language)
+			if err != nil {
+				log.Fatalf("explain: %v", err)
+			}
+			if err := l.explain(os.Stdout, args[1], cat); err != nil {
+				log.Fatalf("explain: %v", err)
+			}
+			os.Exit(0)
+		}
+	}
+	// "doccheck fix ..." is a shorthand for "doccheck -fix ...".
+	if subcommand == "fix" {
+		l.fix = true
+	}
+
+	flags := flag.NewFlagSet("doccheck", flag.ExitOnError)
+	var path string
+	flags.StringVar(&path, "path", "", `path to package to be checked`)
+	flags.IntVar(&l.concurrency, "j", runtime.NumCPU(), `number of packages to check concurrently`)
+	flags.IntVar(&l.concurrency, "concurrency", runtime.NumCPU(), `same as -j`)
+	flags.BoolVar(&l.fix, "fix", l.fix, `apply suggested fixes to the checked files`)
+	flags.BoolVar(&l.scaffold, "scaffold", false, `insert the TODO doc stubs above the undocumented exported declarations`)
+	fixReport := flags.String("report", "", `write a JSON report of the edits applied by -fix or -scaffold to the given file`)
+	flags.IntVar(&l.maxFirstParagraphSentences, "max-first-paragraph-sentences", 3,
+		`max number of sentences in the doc-comment first paragraph`)
+	useCache := flags.Bool("cache", false, `reuse the results for files that were not changed since the last run`)
+	cacheDir := flags.String("cache-dir", defaultCacheDir(), `directory where -cache results are stored`)
+	ownedBy := flags.String("owned-by", "", `check only files owned by the given CODEOWNERS owner, e.g. @org/team`)
+	failOn := flags.String("fail-on", "info", `min severity of the findings that make the exit code non-zero`)
+	flags.IntVar(&l.maxAllowed, "max-allowed", 0, `number of the -fail-on findings that still keep the exit code zero`)
+	severities := flags.String("severity", "", `comma-separated check=severity overrides, checks are names or IDs, e.g. spacing=error,DC008=info`)
+	verbose := flags.Int("v", 0, `log verbosity level: 0 logs only problems, 1 adds loader decisions, 2 adds per-file details`)
+	diff := flags.Bool("diff", false, `report only findings on the lines changed by the unified diff read from the stdin`)
+	diffBase := flags.String("base", "", `report only findings on the lines changed since the given git revision`)
+	configFile := flags.String("config", "", `config file path (default "`+defaultConfigFile+`" if it exists)`)
+	printCoverage := flags.Bool("coverage", false, `print the share of documented exported identifiers per package`)
+	minCoverage := flags.Float64("min-coverage", 0, `fail if less than the given percent of exported identifiers is documented`)
+	format := flags.String("format", "text", `output format: text, grouped to print the file names once, html, json, sarif, github for the Actions annotations, codeclimate for GitLab, rdjson for reviewdog or teamcity`)
+	printStats := flags.Bool("stats", false, `print a summary of the found issues after the run`)
+	auditOut := flags.String("out", "doccheck-audit", `audit reports output directory`)
+	exportedOnly := flags.Bool("exported-only", true, `check only the docs of exported identifiers`)
+	all := flags.Bool("all", false, `check the docs of unexported identifiers too, same as -exported-only=false`)
+	flags.BoolVar(&l.includeGenerated, "include-generated", false, `check files with the "Code generated ... DO NOT EDIT." header too`)
+	flags.BoolVar(&l.includeVendor, "include-vendor", false, `don't skip the vendor directories in the "dir/..." paths`)
+	flags.BoolVar(&l.includeTestdata, "include-testdata", false, `don't skip the testdata directories in the "dir/..." paths`)
+	flags.BoolVar(&l.includeHidden, "include-hidden", false, `don't skip the directories starting with "." or "_" in the "dir/..." paths`)
+	flags.BoolVar(&l.tests, "tests", false, `check _test.go files and external test packages too`)
+	flags.BoolVar(&l.typeInfo, "types", false, `type-check the packages to make the checks more precise`)
+	stdin := flags.Bool("stdin", false, `check a single file read from the stdin`)
+	watch := flags.Bool("watch", false, `re-check the paths whenever their Go files change`)
+	watchInterval := flags.Duration("watch-interval", time.Second, `how often -watch polls the files for changes`)
+	lsp := flags.Bool("lsp", false, `run a Language Server over the stdin and stdout`)
+	stdinFilename := flags.String("stdin-filename", "stdin.go", `file name to use for the -stdin contents`)
+	flags.IntVar(&l.maxIssues, "max-issues", 0, `report at most the given number of findings, 0 means no limit`)
+	flags.BoolVar(&l.maxIssuesStop, "max-issues-stop", false, `stop checking the packages once -max-issues findings are reported`)
+	debug := flags.String("debug", "", `comma-separated debug outputs, only timing is supported for now`)
+	cpuProfile := flags.String("cpuprofile", "", `write the CPU profile to the file`)
+	memProfile := flags.String("memprofile", "", `write the memory profile to the file`)
+	exclude := flags.String("exclude", "", `comma-separated glob patterns of the files and directories to skip, e.g. '**/mocks/**,**/zz_generated*.go'`)
+	plugins := flags.String("plugin", "", `comma-separated Go plugin files that register additional checks`)
+	colorMode := flags.String("color", "auto", `color the text output: always, never or auto to color only the terminal output`)
+	flags.Usage = func() {
+		out := flags.Output()
+		fmt.Fprintf(out, "Usage: doccheck [fix|audit|changed|checks|selftest] [flags] [paths... | @file]\n")
+		fmt.Fprintf(out, "       doccheck explain <check>\n\n")
+		fmt.Fprintf(out, "Subcommands:\n")
+		fmt.Fprintf(out, "  fix\tsame as -fix\n")
+		fmt.Fprintf(out, "  audit\tcheck all modules under the paths and write reports to -out\n")
+		fmt.Fprintf(out, "  changed\tcheck only the packages with the Go files changed in the git work tree\n")
+		fmt.Fprintf(out, "  checks\tlist all checks with their severities and status for the current config\n")
+		fmt.Fprintf(out, "  selftest\tcheck the bundled corpus and the fixture paths with \"// want\" annotations for the current config\n")
+		fmt.Fprintf(out, "  explain\tprint the check rationale and examples, the check is a name or an ID like DC001\n\n")
+		fmt.Fprintf(out, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	// Packages can be passed either via -path or as positional args.
+	paths, err := readResponseFiles(flags.Args())
+	if err != nil {
+		log.Fatalf("read response file: %v", err)
+	}
+	if path != "" {
+		paths = append([]string{path}, paths...)
+	}
+	if subcommand == "audit" && len(paths) == 0 {
+		paths = []string{"."}
+	}
+	if subcommand == "changed" {
+		if len(paths) != 0 {
+			log.Fatalf("changed doesn't accept paths, they are collected from git")
+		}
+		dirs, err := gitChangedDirs()
+		if err != nil {
+			log.Fatalf("changed: %v", err)
+		}
+		if len(dirs) == 0 {
+			// Nothing to check is a success for the pre-commit hooks.
+			os.Exit(0)
+		}
+		paths = dirs
+	}
+	rewrite := l.fix || l.scaffold
+	switch {
+	case *lsp && (*stdin || rewrite || *diff || *diffBase != "" || len(paths) != 0 || subcommand != ""):
+		log.Fatalf("can't use -lsp with paths, subcommands, -stdin, -fix, -scaffold or -diff")
+	case *watch && (*stdin || rewrite || *diff || subcommand != ""):
+		log.Fatalf("can't use -watch with subcommands, -stdin, -fix, -scaffold or -diff")
+	case !slices.Contains(outputFormats, *format):
+		log.Fatalf("-format: unknown format %q (expected %s)", *format, strings.Join(outputFormats, ", "))
+	case *format != "text" && *format != "grouped" && (*lsp || *watch || subcommand == "audit"):
+		log.Fatalf("can't use -format=%s with -lsp, -watch or audit", *format)
+	case *stdin && len(paths) != 0:
+		log.Fatalf("can't use both -stdin and path")
+	case *stdin && rewrite:
+		log.Fatalf("can't use -fix or -scaffold with -stdin")
+	case *stdin && *diff:
+		log.Fatalf("can't use -diff with -stdin, both read the stdin")
+	case *diff && *diffBase != "":
+		log.Fatalf("can't use both -diff and -base")
+	case !*stdin && !*lsp && subcommand != "checks" && subcommand != "selftest" && len(paths) == 0:
+		log.Fatalf("path can't be empty")
+	case l.maxAllowed < 0:
+		log.Fatalf("-max-allowed can't be negative")
+	case *fixReport != "" && !rewrite:
+		log.Fatalf("-report requires -fix or -scaffold")
+	case subcommand == "audit" && (*stdin || rewrite):
+		log.Fatalf("can't use -stdin, -fix or -scaffold with audit")
+	case subcommand == "selftest" && (*stdin || rewrite || *lsp || *watch):
+		log.Fatalf("can't use -stdin, -fix, -scaffold, -lsp or -watch with selftest")
+	}
+
+	l.logger = newLogger(*verbose)
+	l.exportedOnly = *exportedOnly && !*all
+	// The audit roots and the selftest fixtures are walked as they are.
+	if subcommand != "audit" && subcommand != "selftest" {
+		expanded, err := l.expandPaths(paths)
+		if err != nil {
+			log.Fatalf("expand paths: %v", err)
+		}
+		paths = expanded
+	}
+
+	var sev severity
+
+	var conf *Config
+	if *configFile != "" {
+		conf, err = loadConfig(*configFile, true)
+	} else {
+		l.nestedConfigs = true
+		conf, err = l.dirConfig(".")
+	}
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+	if *plugins != "" {
+		if err := loadPlugins(strings.Split(*plugins, ",")); err != nil {
+			log.Fatalf("-plugin: %v", err)
+		}
+	}
+	var overrides []string
+	if *severities != "" {
+		overrides = strings.Split(*severities, ",")
+	}
+	if err := l.applyConfig(conf, overrides); err != nil {
+		log.Fatal(err)
+	}
+	l.excludes = append(l.excludes, compileExcludes(strings.Split(*exclude, ","))...)
+	sev, err = parseSeverity(*failOn)
+	if err != nil {
+		log.Fatalf("-fail-on: %v", err)
+	}
+	l.failOn = sev
+
+	if *debug != "" {
+		for _, d := range strings.Split(*debug, ",") {
+			switch d {
+			case "timing":
+				l.timings = newTimings()
+			default:
+				log.Fatalf("-debug: unknown %q (expected timing)", d)
+			}
+		}
+	}
+	prof, err := startProfiles(*cpuProfile, *memProfile)
+	if err != nil {
+		log.Fatalf("profile: %v", err)
+	}
+
+	if subcommand == "checks" {
+		if err := l.printChecks(os.Stdout); err != nil {
+			log.Fatalf("checks: %v", err)
+		}
+		os.Exit(0)
+	}
+	if subcommand == "selftest" {
+		// The want annotations are in English.
+		selfConf := *conf
+		selfConf.Language = defaultLanguage
+		ok, err := selftest(os.Stdout, paths, Options{Config: &selfConf, Severities: overrides, All: !l.exportedOnly})
+		if err != nil {
+			log.Fatalf("selftest: %v", err)
+		}
+		if !ok {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	text := &textPrinter{w: os.Stderr, grouped: *format == "grouped"}
+	text.color, err = useColor(*colorMode, os.Stderr)
+	if err != nil {
+		log.Fatalf("-color: %v", err)
+	}
+	l.printer = text
+	var collected *issueCollector
+	switch *format {
+	case "json", "sarif", "codeclimate", "rdjson":
+		collected = &issueCollector{}
+		l.printer = collected
+		// Only the JSON and SARIF reports can mark the suppressed findings.
+		l.keepSuppressed = *format == "json" || *format == "sarif"
+	case "github":
+		l.printer = &githubPrinter{w: os.Stdout}
+	case "teamcity":
+		l.printer = &teamcityPrinter{w: os.Stdout, l: l}
+	}
+
+	if *ownedBy != "" {
+		co, err := findCodeOwners(".")
+		if err != nil {
+			log.Fatalf("find CODEOWNERS: %v", err)
+		}
+		l.codeOwners = co
+		l.ownedBy = *ownedBy
+	}
+
+	switch {
+	case *diff:
+		changed, err := parseDiff(os.Stdin)
+		if err != nil {
+			log.Fatalf("-diff: %v", err)
+		}
+		l.changed = changed
+	case *diffBase != "":
+		changed, err := gitDiff(*diffBase)
+		if err != nil {
+			log.Fatalf("-base: %v", err)
+		}
+		l.changed = changed
+	}
+
+	// Fixes are not cached and the stubs need all files parsed,
+	// so the cache is bypassed in -fix and -scaffold modes.
+	if *useCache && !rewrite {
+		l.cache = &resultCache{dir: *cacheDir}
+	}
+
+	if *printStats {
+		l.stats = newRunStats()
+	}
+	if *printCoverage || *minCoverage > 0 {
+		l.coverage = newDocCoverage()
+	}
+
+	var htmlIssues *htmlCollector
+	if *format == "html" {
+		htmlIssues = l.collectHTML()
+	}
+	if *lsp {
+		if err := l.serveLSP(os.Stdin, os.Stdout); err != nil {
+			log.Fatalf("lsp: %v", err)
+		}
+		if err := prof.stop(); err != nil {
+			log.Fatalf("profile: %v", err)
+		}
+		os.Exit(0)
+	}
+	if *watch {
+		l.watch(paths, *watchInterval)
+	}
+	if *stdin {
+		src, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatalf("read stdin: %v", err)
+		}
+		l.checkSource(*stdinFilename, src)
+	} else if subcommand == "audit" {
+		if err := l.audit(paths, *auditOut); err != nil {
+			log.Fatalf("audit: %v", err)
+		}
+	} else {
+		l.Run(paths)
+	}
+	l.Flush()
+
+	if htmlIssues != nil {
+		if err := l.writeHTMLReport(os.Stdout, htmlIssues); err != nil {
+			log.Fatalf("write HTML report: %v", err)
+		}
+	}
+	switch *format {
+	case "json":
+		if err := writeJSONReport(os.Stdout, collected); err != nil {
+			log.Fatalf("write JSON report: %v", err)
+		}
+	case "sarif":
+		if err := l.writeSARIFReport(os.Stdout, collected); err != nil {
+			log.Fatalf("write SARIF report: %v", err)
+		}
+	case "codeclimate":
+		if err := writeCodeClimateReport(os.Stdout, collected); err != nil {
+			log.Fatalf("write Code Climate report: %v", err)
+		}
+	case "rdjson":
+		if err := writeRDJSONReport(os.Stdout, collected); err != nil {
+			log.Fatalf("write rdjson report: %v", err)
+		}
+	}
+
+	if rewrite {
+		applied, err := l.ApplyFixes()
+		if err != nil {
+			log.Fatalf("apply fixes: %v", err)
+		}
+		if *fixReport != "" {
+			if err := writeFixReport(*fixReport, applied); err != nil {
+				log.Fatalf("write fix report: %v", err)
+			}
+		}
+	}
+
+	if l.suppressed != 0 {
+		fmt.Fprintf(os.Stderr, "%d more issues are suppressed by -max-issues=%d\n", l.suppressed, l.maxIssues)
+	} else if l.limitReached() {
+		fmt.Fprintf(os.Stderr, "checking stopped after -max-issues=%d issues\n", l.maxIssues)
+	}
+	if l.maxAllowed > 0 {
+		if l.failures > l.maxAllowed {
+			fmt.Fprintf(os.Stderr, "%d issues exceed -max-allowed=%d\n", l.failures, l.maxAllowed)
+		} else if l.failures != 0 {
+			fmt.Fprintf(os.Stderr, "%d issues are within -max-allowed=%d\n", l.failures, l.maxAllowed)
+		}
+	}
+	if l.stats != nil {
+		l.stats.Print(os.Stderr)
+	}
+	if l.timings != nil {
+		l.timings.Print(os.Stderr)
+	}
+	if l.coverage != nil {
+		if *printCoverage {
+			l.coverage.Print(os.Stderr)
+		}
+		if total := l.coverage.Total().percent(); total < *minCoverage {
+			fmt.Fprintf(os.Stderr, "documentation coverage %.1f%% is below -min-coverage=%v\n", total, *minCoverage)
+			l.belowCoverage = true
+		}
+	}
+
+	if err := prof.stop(); err != nil {
+		log.Fatalf("profile: %v", err)
+	}
+	os.Exit(l.ExitCode())
+}
+
+// newLogger returns a stderr logger for the given -v level.
+func newLogger(verbose int) *slog.Logger {
+	level := slog.LevelWarn
+	switch {
+	case verbose >= 2:
+		level = slog.LevelDebug
+	case verbose == 1:
+		level = slog.LevelInfo
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
+
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "doccheck")
+}
+
+// linter holds the state that is shared between all checked packages.
+//
+// Everything that is mutated during the check must be either
+// protected by mu or moved to the checker.
+type linter struct {
+	concurrency int
+	fix         bool
+
+	// scaffold adds the doc stubs of the undocumented API to the edits.
+	scaffold bool
+
+	maxFirstParagraphSentences int
+
+	fset *token.FileSet
+
+	// settings are the config settings of the current directory.
+	// The packages with own config files use their merged
+	// settings instead, see dirSettings.
+	*settings
+
+	// severityOverrides are the -severity overrides, they're applied
+	// on top of every config.
+	severityOverrides []string
+
+	// nestedConfigs enables the config files lookup in the
+	// checked directories and their parents, see dirSettings.
+	nestedConfigs bool
+
+	// language overrides the configured language, see withLanguage.
+	languageOverride string
+
+	// failOn is a min severity that affects the exit code.
+	failOn severity
+
+	// maxAllowed is a number of the failOn findings that
+	// don't make the run fail yet.
+	maxAllowed int
+
+	logger *slog.Logger
+
+	// cache is nil unless -cache is enabled.
+	cache *resultCache
+
+	// timings is nil unless -debug=timing is enabled.
+	timings *timings
+
+	// changed is nil unless -diff or -base is enabled.
+	changed changedLines
+
+	// exportedOnly limits the declaration checks to the exported identifiers.
+	exportedOnly bool
+
+	// includeGenerated enables the generated files checking.
+	includeGenerated bool
+
+	// includeVendor, includeTestdata and includeHidden disable
+	// skipping of the corresponding directories, see skipDir.
+	includeVendor   bool
+	includeTestdata bool
+	includeHidden   bool
+
+	// tests enables the _test.go files checking.
+	tests bool
+
+	// typeInfo enables the type checking of the packages.
+	typeInfo bool
+
+	// maxIssues limits the number of reported issues, the rest are
+	// only counted. With maxIssuesStop, the packages that are not
+	// started yet are not checked after the limit is reached.
+	maxIssues     int
+	maxIssuesStop bool
+
+	// printer writes the issues unless there is a sink.
+	printer issuePrinter
+
+	// keepSuppressed keeps the issues suppressed by the //nolint
+	// directives, marked with their Suppression, instead of dropping
+	// them. They don't count as the -fail-on failures either way.
+	keepSuppressed bool
+
+	// listedFiles maps the directories to the names of their Go files
+	// given in the paths, only these files of the directories are checked.
+	listedFiles map[string]map[string]bool
+
+	// excludes are the -exclude patterns, matched against
+	// the paths relative to the excludeRoot directory.
+	excludes    []*regexp.Regexp
+	excludeRoot string
+
+	// codeOwners is nil unless -owned-by is enabled.
+	codeOwners *codeOwners
+	ownedBy    string
+
+	mu         sync.Mutex
+	issues     int
+	failures   int
+	printed    int
+	suppressed int
+	edits      []textEdit
+
+	// belowCoverage is set when the coverage is below -min-coverage.
+	belowCoverage bool
+
+	// pending are the issues to be printed by Flush.
+	pending []reportedIssue
+
+	// wordFiles caches the words files contents, see dirWords.
+	wordFiles map[string][]string
+
+	// configFiles caches the config files contents, nil for the
+	// missing ones, and dirConfigs caches the merged settings by
+	// the config files they're made of, see dirSettings.
+	configFiles map[string][]byte
+	dirConfigs  map[string]*settings
+
+	// sink receives all reported issues instead of the printer if it's not nil.
+	// It's called with mu held.
+	sink func(iss issue, sev severity)
+
+	// stats is nil unless -stats is enabled.
+	stats *runStats
+
+	// coverage is nil unless -coverage or -min-coverage is enabled.
+	coverage *docCoverage
+}
+
+// checker holds the per-package check state.
+type checker struct {
+	*linter
+
+	// settings are the package directory settings, they shadow
+	// the linter ones.
+	*settings
+
+	path string
+
+	// sources maps file names to their contents.
+	sources map[string][]byte
+
+	// cached maps file names to their results loaded from the cache.
+	cached map[string][]issue
+
+	// declared are the names declared by the package,
+	// see declaredNames.
+	declared map[string]bool
+
+	// nolint are the //nolint directives of the package files,
+	// see collectNolint.
+	nolint map[string][]nolintRange
+
+	// pkgKey is the package results cache key.
+	pkgKey string
+
+	// words are the words accepted by the prose checks, see dirWords.
+	words *wordList
+
+	// importPath is the package import path according to go.mod,
+	// empty if it's unknown.
+	importPath string
+
+	// types is nil unless -types is enabled.
+	types *types.Info
+
+	current struct {
+		fn *ast.FuncDecl
+
+		// name is the documented identifier of the checked doc.
+		name string
+
+		// testSeam is set when current file is a _test.go file
+		// of a non-external test package.
+		testSeam bool
+
+		// issues collects the current file results for the cache.
+		issues *[]issue
+
+		// fixes are the edits suggested for the next reported issue.
+		fixes []textEdit
+	}
+}
+
+func (s *settings) initRegexps() {
+	// Empty lists disable the corresponding predicate rules.
+	var prefixes []string
+	for _, p := range s.predicatePrefixes {
+		if p == "" {
+			continue
+		}
+		prefixes = append(prefixes, regexp.QuoteMeta(p))
+		if lower := strings.ToLower(p[:1]) + p[1:]; lower != p {
+			prefixes = append(prefixes, regexp.QuoteMeta(lower))
+		}
+	}
+	if len(prefixes) != 0 {
+		pat := `^(?:` + strings.Join(prefixes, "|") + `)[A-Z0-9]\w*$`
+		s.regexp.predPrefix = regexp.MustCompile(pat)
+	}
+
+	if len(s.predicatePhrases) != 0 {
+		patterns := make([]string, len(s.predicatePhrases))
+		for i, p := range s.predicatePhrases {
+			patterns[i] = " " + regexp.QuoteMeta(p) + " "
+		}
+		pat := strings.Join(patterns, "|")
+		s.regexp.predAntipattern = regexp.MustCompile(pat)
+	}
+
+	s.regexp.directiveLike = regexp.MustCompile(`^//\w+: `)
+	s.regexp.thisOpening = regexp.MustCompile(`^This (?:function|func|method|type|struct|interface|constant|variable)\b`)
+}
+
+// Run checks all packages from paths using a pool of l.concurrency workers.
+func (l *linter) Run(paths []string) {
+	workers := l.concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(paths) {
+		workers = len(paths)
+	}
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				if l.isExcluded(path) {
+					l.logger.Info("skipping excluded directory", "path", path)
+					continue
+				}
+				if l.limitReached() {
+					l.logger.Debug("skipping package after -max-issues", "path", path)
+					continue
+				}
+				l.checkDir(path)
+			}
+		}()
+	}
+	for _, path := range paths {
+		jobs <- path
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// limitReached reports whether the checking should stop
+// because of the -max-issues limit.
+func (l *linter) limitReached() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.maxIssuesStop && l.maxIssues > 0 && l.issues >= l.maxIssues
+}
+
+// brokenFile is a Go file that failed to parse.
+type brokenFile struct {
+	name string
+	src  []byte
+	err  error
+}
+
+func (l *linter) checkDir(path string) {
+	defer l.timePackage(path)()
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		log.Fatalf("read path: %v", err)
+	}
+
+	l.logger.Info("loading package directory", "path", path)
+	var filenames []string
+	sources := make(map[string][]byte)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			l.logger.Debug("skipping non-Go entry", "path", filepath.Join(path, e.Name()))
+			continue
+		}
+		if listed := l.listedFiles[path]; listed != nil && !listed[e.Name()] {
+			l.logger.Debug("skipping file not listed in the paths", "path", filepath.Join(path, e.Name()))
+			continue
+		}
+		if l.isExcluded(filepath.Join(path, e.Name())) {
+			l.logger.Debug("skipping excluded file", "path", filepath.Join(path, e.Name()))
+			continue
+		}
+		if !l.tests && strings.HasSuffix(e.Name(), "_test.go") {
+			l.logger.Debug("skipping test file", "path", filepath.Join(path, e.Name()))
+			continue
+		}
+		filename := filepath.Join(path, e.Name())
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			log.Fatalf("read file: %v", err)
+		}
+		if !l.includeGenerated && isGenerated(src) {
+			l.logger.Debug("skipping generated file", "path", filename)
+			continue
+		}
+		filenames = append(filenames, filename)
+		sources[filename] = src
+	}
+	l.countScanned(len(filenames))
+
+	// Package-level checks need all package files, so their results
+	// are cached separately. When the package and all file results
+	// are cached, the files don't need to be parsed at all. Otherwise
+	// all files are parsed, as the file checks use the names that
+	// the other package files declare.
+	s, err := l.dirSettings(path)
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+	c := &checker{linter: l, settings: s, path: path, sources: sources, words: l.dirWords(path), importPath: moduleImportPath(path)}
+	cached := make(map[string][]issue)
+	var pkgIssues []issue
+	pkgCached := false
+	if l.cache != nil {
+		c.pkgKey = c.packageCacheKey(filenames, sources, c.words, c.importPath)
+		pkgIssues, pkgCached = l.cache.load(c.pkgKey)
+		for _, filename := range filenames {
+			issues, ok := l.cache.load(c.fileCacheKey(filename, sources[filename], c.pkgKey, c.words))
+			if ok {
+				l.logger.Debug("cache hit", "file", filename, "issues", len(issues))
+				cached[filename] = issues
+			}
+		}
+	}
+	c.cached = cached
+	allCached := pkgCached && len(cached) == len(filenames)
+
+	packages := make(map[string]*ast.Package)
+	parsed := make(map[string]*ast.File)
+	broken := make(map[string]brokenFile)
+	for _, filename := range filenames {
+		// Coverage is counted from the AST, so it needs all files parsed.
+		if allCached && l.coverage == nil {
+			continue
+		}
+		src := sources[filename]
+		f, err := parser.ParseFile(l.fset, filename, src, parser.ParseComments)
+		if err == nil {
+			parsed[filename] = f
+		} else {
+			l.logger.Info("parse failed, using token-stream fallback", "file", filename, "err", err)
+			broken[filename] = brokenFile{name: filename, src: src, err: err}
+			// Package clause is usually still parsable, so the file
+			// can take part in the package doc-comment checks.
+			f, err = parser.ParseFile(l.fset, filename, src, parser.PackageClauseOnly|parser.ParseComments)
+			if err != nil {
+				l.logger.Info("package clause is unparsable, skipping package-level checks", "file", filename)
+				continue
+			}
+		}
+		pkg := packages[f.Name.Name]
+		if pkg == nil {
+			pkg = &ast.Package{Name: f.Name.Name, Files: make(map[string]*ast.File)}
+			packages[f.Name.Name] = pkg
+		}
+		pkg.Files[filename] = f
+	}
+
+	if l.coverage != nil {
+		for _, pkg := range packages {
+			l.countCoverage(path, pkg)
+		}
+	}
+	if l.scaffold {
+		for _, pkg := range packages {
+			c.scaffoldDocs(pkg)
+		}
+	}
+	var files []*ast.File
+	for _, pkg := range packages {
+		files = append(files, sortedFiles(pkg)...)
+	}
+	c.declared = declaredNames(files)
+	c.nolint = collectNolint(l.fset, files)
+	if l.typeInfo && len(parsed) != 0 {
+		c.types = l.typeCheck(path, c.importPath, packages)
+	}
+
+	if pkgCached {
+		l.logger.Debug("cache hit", "path", path, "issues", len(pkgIssues))
+		c.replay(pkgIssues)
+	} else {
+		c.runCached(c.pkgKey, func() {
+			for _, pkg := range packages {
+				// All files take part in the package doc-comment checks,
+				// but they're only reported if the package is owned.
+				owned := false
+				for filename := range pkg.Files {
+					owned = owned || l.isOwned(filename)
+				}
+				if !owned {
+					l.logger.Info("skipping package not owned by "+l.ownedBy, "path", path, "name", pkg.Name)
+					continue
+				}
+				l.logger.Debug("checking package", "path", path, "name", pkg.Name, "files", len(pkg.Files))
+				c.runPackageChecks(pkg)
+			}
+		})
+	}
+
+	for _, filename := range filenames {
+		if !l.isOwned(filename) {
+			l.logger.Debug("skipping file not owned by "+l.ownedBy, "file", filename)
+			continue
+		}
+		c.checkFileCached(filename, func() {
+			if f, ok := parsed[filename]; ok {
+				c.CheckFile(f)
+			} else if f, ok := broken[filename]; ok {
+				c.checkBrokenFile(f)
+			}
+		})
+	}
+}
+
+// runPackageChecks runs the checks that need all package files.
+func (c *checker) runPackageChecks(pkg *ast.Package) {
+	c.timed("CheckPackage", func() { c.CheckPackage(pkg) })
+	c.timed("checkInterfaceDocDup", func() { c.checkInterfaceDocDup(pkg) })
+	c.timed("checkIdentStyle", func() { c.checkIdentStyle(pkg) })
+	c.timed("checkDuplicateDocs", func() { c.checkDuplicateDocs(pkg) })
+	c.timed("checkMethodDocs", func() { c.checkMethodDocs(pkg) })
+	c.timed("checkSpelling", func() { c.checkSpelling(pkg) })
+	c.timed("checkErrorDecls", func() { c.checkErrorDecls(pkg) })
+	c.timed("checkImportComments", func() { c.checkImportComments(pkg) })
+}
+
+// checkSource checks a single file with the given contents.
+//
+// Package-level checks are not executed as the other
+// package files are unknown.
+func (l *linter) checkSource(filename string, src []byte) {
+	if !l.includeGenerated && isGenerated(src) {
+		l.logger.Debug("skipping generated file", "path", filename)
+		return
+	}
+	l.countScanned(1)
+	sources := map[string][]byte{filename: src}
+	c := &checker{linter: l, settings: l.settings, path: filepath.Dir(filename), sources: sources}
+	if s, err := l.dirSettings(c.path); err == nil {
+		c.settings = s
+	} else {
+		l.logger.Warn("can't load the config, using the top-level one", "path", c.path, "err", err)
+	}
+	c.words = l.dirWords(c.path)
+	f, err := parser.ParseFile(l.fset, filename, src, parser.ParseComments)
+	if err != nil {
+		c.checkBrokenFile(brokenFile{name: filename, src: src, err: err})
+		return
+	}
+	c.declared = declaredNames([]*ast.File{f})
+	c.nolint = collectNolint(l.fset, []*ast.File{f})
+	c.CheckFile(f)
+}
+
+var (
+	// generatedRegexp matches the https://go.dev/s/generatedcode header.
+	generatedRegexp = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+	packageClauseRegexp = regexp.MustCompile(`(?m)^package\s`)
+)
+
+// isGenerated reports whether the file source has the generated code header
+// before the package clause. Unlike ast.IsGenerated, it doesn't require
+// the file to be parsed, so broken and cached files can be skipped too.
+func isGenerated(src []byte) bool {
+	end := len(src)
+	if loc := packageClauseRegexp.FindIndex(src); loc != nil {
+		end = loc[0]
+	}
+	return generatedRegexp.Match(src[:end])
+}
+
+// isOwned reports whether filename is owned by the -owned-by owner.
+// All files are owned when -owned-by is not set.
+func (l *linter) isOwned(filename string) bool {
+	if l.codeOwners == nil {
+		return true
+	}
+	return l.codeOwners.IsOwnedBy(filename, l.ownedBy)
+}
+
+// issue is a single check finding.
+type issue struct {
+	Check string `json:"check"`
+
+	// Pos is the reported finding location.
+	// Package-level findings may have only the Filename set.
+	Pos token.Position `json:"pos"`
+
+	// End is the end of the reported source range, exclusive.
+	// It's unset when the finding is not about a specific range.
+	End token.Position `json:"end"`
+
+	// FromLine and ToLine describe the source lines range
+	// the finding is about. Both are 0 for package-level findings.
+	FromLine int `json:"from_line,omitempty"`
+	ToLine   int `json:"to_line,omitempty"`
+
+	// TestSeam is set for the findings about exported symbols
+	// declared in the package _test.go files, like export_test.go.
+	// Such symbols are only visible to tests and are reported
+	// with the reduced severity.
+	TestSeam bool `json:"test_seam,omitempty"`
+
+	// Name is the documented identifier, empty if it's unknown.
+	Name string `json:"name,omitempty"`
+
+	Message string `json:"message"`
+
+	// Fixes are the suggested edits that resolve the finding.
+	Fixes []SuggestedFix `json:"fixes,omitempty"`
+
+	// Suppression is the //nolint directive that suppresses
+	// the finding, nil if it's not suppressed.
+	Suppression *Suppression `json:"suppression,omitempty"`
+}
+
+func (l *linter) countScanned(n int) {
+	if l.stats == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.stats.filesScanned += n
+}
+
+func (c *checker) report(iss issue) {
+	if c.changed != nil && !c.changed.Touches(iss.Pos.Filename, iss.FromLine, iss.ToLine) {
+		return
+	}
+	if iss.Suppression != nil && !c.keepSuppressed {
+		return
+	}
+	// The package-level findings are reported if any file of the package is owned.
+	if strings.HasSuffix(iss.Pos.Filename, ".go") && !c.isOwned(iss.Pos.Filename) {
+		return
+	}
+	sev := c.severities[iss.Check]
+	if iss.TestSeam && sev > c.testSeamSeverity {
+		sev = c.testSeamSeverity
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.issues++
+	if c.stats != nil && iss.Suppression == nil {
+		c.stats.addIssue(iss)
+	}
+	if sev >= c.failOn && iss.Suppression == nil {
+		c.failures++
+	}
+	// Only the reported findings are fixed, so -fix with -diff keeps
+	// the unchanged lines, and the suppressed findings are kept as
+	// they are.
+	if c.fix && iss.Suppression == nil {
+		for _, fix := range iss.Fixes {
+			c.edits = append(c.edits, textEdit{
+				check:    iss.Check,
+				filename: fix.Pos.Filename,
+				start:    fix.Pos.Offset,
+				end:      fix.End.Offset,
+				newText:  fix.NewText,
+			})
+		}
+	}
+	iss.Message = c.formatMessage(iss, sev)
+	if c.sink == nil {
+		c.pending = append(c.pending, reportedIssue{iss: iss, sev: sev})
+		return
+	}
+	if c.maxIssues > 0 && c.issues > c.maxIssues {
+		c.suppressed++
+		return
+	}
+	c.sink(iss, sev)
+}
+
+func (c *checker) warn(iss issue) {
+	if iss.Name == "" {
+		iss.Name = c.current.name
+	}
+	iss.Suppression = c.suppression(iss)
+	for _, e := range c.current.fixes {
+		iss.Fixes = append(iss.Fixes, c.suggestedFix(e))
+	}
+	c.current.fixes = nil
+	if c.current.issues != nil {
+		*c.current.issues = append(*c.current.issues, iss)
+	}
+	c.report(iss)
+}
+
+func (c *checker) warnPkg(check, fileName, format string, args ...interface{}) {
+	if fileName == "" {
+		fileName = c.path
+	}
+	c.warn(issue{
+		Check:   check,
+		Pos:     token.Position{Filename: fileName},
+		Message: c.catalog.sprintf(format, args...),
+	})
+}
+
+func (c *checker) warnComment(check string, comment *ast.Comment, format string, args ...interface{}) {
+	pos := c.fset.Position(comment.Pos())
+	end := c.fset.Position(comment.End())
+	c.warn(issue{
+		Check:    check,
+		Pos:      pos,
+		End:      end,
+		FromLine: pos.Line,
+		ToLine:   end.Line,
+		Message:  c.catalog.sprintf(format, args...),
+	})
+}
+
+// warnGroup reports an issue about the whole comment group.
+func (c *checker) warnGroup(check string, group *ast.CommentGroup, format string, args ...interface{}) {
+	pos := c.fset.Position(group.Pos())
+	end := c.fset.Position(group.End())
+	c.warn(issue{
+		Check:    check,
+		Pos:      pos,
+		End:      end,
+		FromLine: pos.Line,
+		ToLine:   end.Line,
+		Message:  c.catalog.sprintf(format, args...),
+	})
+}
+
+// warnIdent reports an issue about the declared identifier.
+func (c *checker) warnIdent(check string, ident *ast.Ident, format string, args ...interface{}) {
+	pos := c.fset.Position(ident.Pos())
+	c.warn(issue{
+		Check:    check,
+		Pos:      pos,
+		End:      c.fset.Position(ident.End()),
+		FromLine: pos.Line,
+		ToLine:   pos.Line,
+		Name:     ident.Name,
+		Message:  c.catalog.sprintf(format, args...),
+	})
+}
+
+func (c *checker) warnFunc(check, format string, args ...interface{}) {
+	fn := c.current.fn
+	pos := c.fset.Position(fn.Pos())
+	fromLine := pos.Line
+	if fn.Doc != nil {
+		fromLine = c.fset.Position(fn.Doc.Pos()).Line
+	}
+	// The functions of the broken files have no names.
+	var name string
+	if fn.Name != nil {
+		name = fn.Name.Name
+	}
+	c.warn(issue{
+		Check:    check,
+		Pos:      pos,
+		FromLine: fromLine,
+		ToLine:   pos.Line,
+		TestSeam: c.current.testSeam && fn.Name != nil && fn.Name.IsExported(),
+		Name:     name,
+		Message:  c.catalog.sprintf(format, args...),
+	})
+}
+
+// warnFuncRange reports an issue about the [from, to) range
+// of the current function doc-comment.
+//
+// Like with warnFunc, the finding lines span from the doc-comment
+// start to the function declaration, so the -diff filter keeps
+// the findings for the changed function signatures too.
+func (c *checker) warnFuncRange(check string, from, to token.Pos, format string, args ...interface{}) {
+	fn := c.current.fn
+	fromLine := c.fset.Position(from).Line
+	if fn.Doc != nil {
+		fromLine = c.fset.Position(fn.Doc.Pos()).Line
+	}
+	var name string
+	if fn.Name != nil {
+		name = fn.Name.Name
+	}
+	c.warn(issue{
+		Check:    check,
+		Pos:      c.fset.Position(from),
+		End:      c.fset.Position(to),
+		FromLine: fromLine,
+		ToLine:   c.fset.Position(fn.Pos()).Line,
+		TestSeam: c.current.testSeam && fn.Name != nil && fn.Name.IsExported(),
+		Name:     name,
+		Message:  c.catalog.sprintf(format, args...),
+	})
+}
+
+// ExitCode returns 1 if there are more -fail-on findings than
+// -max-allowed or the coverage is below -min-coverage, and 0 otherwise.
+func (l *linter) ExitCode() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failures <= l.maxAllowed && !l.belowCoverage {
+		return 0
+	}
+	return 1
+}
+
+func (c *checker) CheckPackage(pkg *ast.Package) {
+	var docFilename string
+	var doc *ast.CommentGroup
+	count := 0
+	for filename, f := range pkg.Files {
+		// The license headers are reported by the license-header check.
+		if d := packageDoc(f); d != nil {
+			count++
+			doc = d
+			docFilename = filename
+		}
+	}
+
+	switch count {
+	case 1:
+		// Good. Safe to run other checks.
+	case 0:
+		c.warnPkg("package-doc", "", "no doc-comment found")
+		return
+	default:
+		c.warnPkg("package-doc", "", "found %d doc-comments, expected 1", count)
+		return
+	}
+
+	c.checkCustomRules(targetPackage, doc)
+
+	c.checkPackageSynopsis(pkg, doc)
+	if pkg.Name != "main" {
+		c.checkPackagePrefix(pkg, doc)
+	} else {
+		c.checkCommandDoc(doc)
+	}
+
+	if pkg.Name != "main" || c.docGo.CheckMain {
+		c.checkDocGo(pkg, docFilename, doc)
+	}
+}
+
+func (c *checker) checkDocGo(pkg *ast.Package, docFilename string, doc *ast.CommentGroup) {
+	if filepath.Base(docFilename) == "doc.go" {
+		return
+	}
+
+	if c.docGo.Strict {
+		for filename := range pkg.Files {
+			if filepath.Base(filename) == "doc.go" {
+				c.warnPkg("doc-go", docFilename, "package doc-comment should go into the existing doc.go file")
+				return
+			}
+		}
+	}
+
+	lines := 0
+	for _, comment := range doc.List {
+		lines += strings.Count(comment.Text, "\n") + 1
+	}
+	if lines > c.docGo.MaxLines {
+		c.warnPkg("doc-go", docFilename, "long doc-comments should go into doc.go file")
+	}
+}
+
+// checkCommandDoc checks that main package doc-comment follows
+// the command documentation style: it starts with the command name
+// (either capitalized or not) or with "Command".
+func (c *checker) checkCommandDoc(doc *ast.CommentGroup) {
+	command := c.commandName()
+	if command == "" {
+		return
+	}
+
+	text := doc.Text()
+	fields := strings.Fields(text)
+	if len(fields) == 0 || (!strings.EqualFold(fields[0], command) && fields[0] != "Command") {
+		c.warnComment("command-doc", doc.List[0], "command doc-comment should start with %q or \"Command\"",
+			strings.ToUpper(command[:1])+command[1:])
+	}
+
+	if c.commandUsage && !strings.Contains(strings.ToLower(text), "usage") {
+		c.warnComment("command-usage", doc.List[0], "command doc-comment should describe the usage")
+	}
+}
+
+// commandName returns the name of the binary that go build makes
+// of the main package: the last element of the import path, without
+// the major version suffix, like "tool" for "example.com/tool/v2".
+// The directory name is used when the import path is unknown.
+func (c *checker) commandName() string {
+	if c.importPath == "" {
+		dir, err := filepath.Abs(c.path)
+		if err != nil {
+			return ""
+		}
+		return filepath.Base(dir)
+	}
+	elems := strings.Split(c.importPath, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && isMajorVersion(name) {
+		name = elems[len(elems)-2]
+	}
+	return name
+}
+
+// isMajorVersion reports whether the import path element
+// is a major version suffix like "v2".
+func isMajorVersion(elem string) bool {
+	n, err := strconv.Atoi(strings.TrimPrefix(elem, "v"))
+	return strings.HasPrefix(elem, "v") && err == nil && n >= 2 && elem == "v"+strconv.Itoa(n)
+}
+
+// checkPackagePrefix checks that package doc-comment
+// starts with "Package <name>" as godoc convention suggests.
+func (c *checker) checkPackagePrefix(pkg *ast.Package, doc *ast.CommentGroup) {
+	if strings.HasSuffix(pkg.Name, "_test") {
+		// External test packages docs are not rendered by godoc.
+		return
+	}
+	fields := strings.Fields(doc.Text())
+	switch {
+	case len(fields) < 2 || fields[0] != "Package":
+		c.warnComment("package-prefix", doc.List[0], "package doc-comment should start with \"Package %s\"", pkg.Name)
+	case strings.TrimRight(fields[1], ".,:;") != pkg.Name:
+		c.warnComment("package-prefix", doc.List[0], "package doc-comment refers to package %s, expected %s", fields[1], pkg.Name)
+	}
+}
+
+// checkPackageSynopsis checks the first sentence of the package
+// doc-comment that is displayed in the package lists.
+// The synopsis is extracted with the go/doc rules.
+func (c *checker) checkPackageSynopsis(pkg *ast.Package, doc *ast.CommentGroup) {
+	if strings.HasSuffix(pkg.Name, "_test") {
+		return
+	}
+
+	synopsis := new(godoc.Package).Synopsis(doc.Text())
+	switch {
+	case synopsis == "":
+		c.warnComment("package-synopsis", doc.List[0], "package doc-comment has no synopsis sentence")
+	case strings.HasPrefix(synopsis, "This package"):
+		c.warnComment("package-synopsis", doc.List[0], "package synopsis should not start with \"This package\"")
+	case len(synopsis) > c.maxSynopsisLength:
+		c.warnComment("package-synopsis", doc.List[0], "package synopsis is %d characters long, keep it under %d",
+			len(synopsis), c.maxSynopsisLength)
+	case !unicode.IsUpper([]rune(synopsis)[0]) || !strings.HasSuffix(synopsis, "."):
+		c.warnComment("package-synopsis", doc.List[0], "package synopsis should be a sentence that ends with a period")
+	}
+}
+
+// checkInterfaceDocDup finds methods which doc-comments are
+// verbatim copies of the same package interface method docs.
+// Such copies tend to drift apart from the original over time.
+func (c *checker) checkInterfaceDocDup(pkg *ast.Package) {
+	type ifaceMethod struct {
+		iface string
+		doc   string
+	}
+	ifaceMethods := make(map[string][]ifaceMethod)
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				iface, ok := spec.Type.(*ast.InterfaceType)
+				if !ok {
+					continue
+				}
+				for _, m := range iface.Methods.List {
+					if len(m.Names) != 1 || m.Doc == nil {
+						continue
+					}
+					name := m.Names[0].Name
+					ifaceMethods[name] = append(ifaceMethods[name], ifaceMethod{
+						iface: spec.Name.Name,
+						doc:   normalizeDoc(m.Doc),
+					})
+				}
+			}
+		}
+	}
+	if len(ifaceMethods) == 0 {
+		return
+	}
+
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Recv == nil || decl.Doc == nil {
+				continue
+			}
+			doc := normalizeDoc(decl.Doc)
+			for _, m := range ifaceMethods[decl.Name.Name] {
+				if m.doc == doc {
+					c.current.fn = decl
+					c.warnFuncRange("interface-doc-dup", decl.Doc.Pos(), decl.Doc.End(), "doc-comment duplicates %s.%s doc, omit it or refer to the interface instead",
+						m.iface, decl.Name.Name)
+					break
+				}
+			}
+		}
+	}
+}
+
+// normalizeDoc returns doc text with all whitespace sequences
+// replaced by a single space.
+func normalizeDoc(doc *ast.CommentGroup) string {
+	return normalizeSpace(doc.Text())
+}
+
+func (c *checker) CheckFile(f *ast.File) {
+	filename := c.fset.Position(f.Pos()).Filename
+	c.current.testSeam = strings.HasSuffix(filename, "_test.go") && !strings.HasSuffix(f.Name.Name, "_test")
+	defer func() { c.current.testSeam = false }()
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Doc != nil && !isTestFunc(filename, decl) && c.isCheckedDecl(funcDeclDoc(decl)) {
+				c.current.fn = decl
+				c.current.name = decl.Name.Name
+				doc := decl.Doc
+				c.timed("checkBoolFuncStyle", func() { c.checkBoolFuncStyle(doc) })
+				c.timed("checkMethodDoc", func() { c.checkMethodDoc(doc) })
+				c.timed("checkConstructorDoc", func() { c.checkConstructorDoc(doc) })
+				c.timed("checkPanicDoc", func() { c.checkPanicDoc(f, doc) })
+				c.timed("checkAccessorDoc", func() { c.checkAccessorDoc(doc) })
+				c.timed("checkErrorDoc", func() { c.checkErrorDoc(doc) })
+				c.timed("checkParamRefs", func() { c.checkParamRefs(doc) })
+				c.timed("checkCommentStyle", func() { c.checkCommentStyle(doc) })
+				c.timed("checkCustomRules", func() { c.checkCustomRules(targetFunc, doc) })
+			}
+		}
+	}
+	c.current.name = ""
+
+	if f.Doc != nil {
+		c.timed("checkLicenseHeader", func() { c.checkLicenseHeader(f) })
+		c.timed("checkDirectivePlacement", func() { c.checkDirectivePlacement(f.Doc) })
+		c.timed("checkCodeBlocks", func() { c.checkCodeBlocks(f.Doc) })
+		c.timed("checkURLs", func() { c.checkURLs(f.Doc) })
+		c.timed("checkMarkdown", func() { c.checkMarkdown(f.Doc) })
+		c.timed("checkDeprecated", func() { c.checkDeprecated(f.Doc) })
+		c.timed("checkTrailingSpace", func() { c.checkTrailingSpace(f.Doc) })
+		c.timed("checkTypography", func() { c.checkTypography(f.Doc) })
+		c.timed("checkDoubleSpace", func() { c.checkDoubleSpace(f.Doc) })
+		c.timed("checkRepeatedWords", func() { c.checkRepeatedWords(f.Doc) })
+		c.timed("checkSentenceCase", func() { c.checkSentenceCase(declDoc{doc: f.Doc}) })
+		c.timed("checkDocTags", func() { c.checkDocTags(f.Doc) })
+		c.timed("checkInitialisms", func() { c.checkInitialisms(f.Doc) })
+		c.timed("checkFillerWords", func() { c.checkFillerWords(f.Doc) })
+		c.timed("checkWrap", func() { c.checkWrap(f.Doc) })
+		c.timed("runChecks", func() { c.runChecks(TargetPackage, f, declDoc{}, f.Doc) })
+	}
+	for _, d := range fileDeclDocs(f) {
+		if !c.isCheckedDecl(d) {
+			continue
+		}
+		c.current.name = d.name
+		c.timed("checkDirectivePlacement", func() { c.checkDirectivePlacement(d.doc) })
+		c.timed("checkCodeBlocks", func() { c.checkCodeBlocks(d.doc) })
+		c.timed("checkURLs", func() { c.checkURLs(d.doc) })
+		c.timed("checkMarkdown", func() { c.checkMarkdown(d.doc) })
+		c.timed("checkThisOpening", func() { c.checkThisOpening(d) })
+		c.timed("checkNameTypo", func() { c.checkNameTypo(d) })
+		c.timed("checkDeprecated", func() { c.checkDeprecated(d.doc) })
+		c.timed("checkTrailingSpace", func() { c.checkTrailingSpace(d.doc) })
+		c.timed("checkTypography", func() { c.checkTypography(d.doc) })
+		c.timed("checkDoubleSpace", func() { c.checkDoubleSpace(d.doc) })
+		c.timed("checkRepeatedWords", func() { c.checkRepeatedWords(d.doc) })
+		c.timed("checkSentenceCase", func() { c.checkSentenceCase(d) })
+		c.timed("checkDocTags", func() { c.checkDocTags(d.doc) })
+		c.timed("checkInitialisms", func() { c.checkInitialisms(d.doc) })
+		c.timed("checkFillerWords", func() { c.checkFillerWords(d.doc) })
+		c.timed("checkWrap", func() { c.checkWrap(d.doc) })
+		c.timed("runChecks", func() { c.runChecks(TargetDecl, f, d, d.doc) })
+	}
+	c.current.name = ""
+	c.timed("checkTypeAliases", func() { c.checkTypeAliases(f) })
+	c.timed("checkEnumDocs", func() { c.checkEnumDocs(f) })
+	c.timed("checkTypeParamDocs", func() { c.checkTypeParamDocs(f) })
+
+	c.timed("checkBugNotes", func() { c.checkBugNotes(f) })
+	c.timed("checkBuildConstraints", func() { c.checkBuildConstraints(f) })
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			c.timed("checkDirectiveTypo", func() { c.checkDirectiveTypo(comment) })
+			c.timed("checkDirectiveFormat", func() { c.checkDirectiveFormat(comment) })
+		}
+		c.timed("checkCustomRules", func() { c.checkCustomRules(targetAny, group) })
+		c.timed("runChecks", func() { c.runChecks(TargetComment, f, declDoc{}, group) })
+	}
+}
+
+// isCheckedDecl reports whether the declaration docs are checked.
+// With -exported-only, these are exported functions and types, and
+// the exported methods of the exported types. Docs of the grouped
+// declarations are always checked.
+func (l *linter) isCheckedDecl(d declDoc) bool {
+	if !l.exportedOnly || d.name == "" {
+		return true
+	}
+	return ast.IsExported(d.name) && (d.recv == "" || ast.IsExported(d.recv))
+}
+
+// isTestFunc reports whether decl is a test, benchmark, fuzz test
+// or example function of a _test.go file. Their docs are not a part
+// of the package API, so the doc-comment conventions don't apply.
+func isTestFunc(filename string, decl *ast.FuncDecl) bool {
+	if decl.Recv != nil || !strings.HasSuffix(filename, "_test.go") {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz", "Example"} {
+		suffix, ok := strings.CutPrefix(decl.Name.Name, prefix)
+		if ok && (suffix == "" || !unicode.IsLower(rune(suffix[0]))) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkBrokenFile is a CheckFile fallback for files that can't be parsed.
+//
+// Doc-comments are recovered from the token stream: comment group
+// that is immediately followed by the func keyword is treated
+// as a function doc-comment. Only the checks that don't need
+// the function AST are executed.
+func (c *checker) checkBrokenFile(f brokenFile) {
+	if list, ok := f.err.(scanner.ErrorList); ok && len(list) != 0 {
+		pos := list[0].Pos
+		c.warn(issue{
+			Check:    "parse",
+			Pos:      pos,
+			FromLine: pos.Line,
+			ToLine:   pos.Line,
+			Message:  c.catalog.sprintf("parse error: %s", list[0].Msg),
+		})
+	}
+
+	file := c.fset.AddFile(f.name, -1, len(f.src))
+	var s scanner.Scanner
+	s.Init(file, f.src, nil, scanner.ScanComments)
+
+	var group []*ast.Comment
+	lastLine := 0
+	for {
+		pos, tok, lit := s.Scan()
+		switch tok {
+		case token.EOF:
+			return
+		case token.COMMENT:
+			if len(group) != 0 && file.Line(pos) > lastLine+1 {
+				group = nil
+			}
+			comment := &ast.Comment{Slash: pos, Text: lit}
+			c.checkDirectiveTypo(comment)
+			c.checkDirectiveFormat(comment)
+			group = append(group, comment)
+			lastLine = file.Line(pos + token.Pos(len(lit)-1))
+			continue
+		case token.FUNC:
+			if len(group) != 0 && file.Line(pos) == lastLine+1 {
+				// Only positions are needed for the reporting.
+				doc := &ast.CommentGroup{List: group}
+				c.current.fn = &ast.FuncDecl{Doc: doc, Type: &ast.FuncType{Func: pos}}
+				c.checkCommentStyle(doc)
+			}
+		}
+		group = nil
+	}
+}
+
+// checkCommentStyle runs checks that only inspect the comment text.
+func (c *checker) checkCommentStyle(doc *ast.CommentGroup) {
+	c.checkNoMultiline(doc)
+	c.checkEndsWithPunct(doc)
+	c.checkSpacing(doc)
+	c.checkFirstParagraph(doc)
+}
+
+func (c *checker) checkFirstParagraph(doc *ast.CommentGroup) {
+	var paragraph []*ast.Comment
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			return
+		}
+		if strings.TrimSpace(comment.Text[len("//"):]) == "" {
+			break
+		}
+		if isDirective(comment.Text) {
+			break
+		}
+		paragraph = append(paragraph, comment)
+	}
+
+	// Join the paragraph lines, remembering where each of them starts,
+	// so the sentence offsets can be mapped back to the comments.
+	var text strings.Builder
+	starts := make([]int, len(paragraph))
+	prefixes := make([]int, len(paragraph))
+	for i, comment := range paragraph {
+		if i != 0 {
+			text.WriteByte('\n')
+		}
+		line := strings.TrimPrefix(comment.Text, "//")
+		line = strings.TrimPrefix(line, " ")
+		starts[i] = text.Len()
+		prefixes[i] = len(comment.Text) - len(line)
+		text.WriteString(line)
+	}
+
+	sentences := c.tokenizer.Sentences(text.String())
+	if len(sentences) <= c.maxFirstParagraphSentences {
+		return
+	}
+	end := sentences[0].End
+	i := len(starts) - 1
+	for starts[i] >= end {
+		i--
+	}
+	c.fixFirstParagraph(paragraph[i], end-starts[i]+prefixes[i])
+	c.warnFuncRange("first-paragraph", paragraph[0].Pos(), paragraph[len(paragraph)-1].End(), "first paragraph has %d sentences, separate a short synopsis with an empty line", len(sentences))
+}
+
+// fixFirstParagraph inserts a paragraph break after the first sentence
+// that ends at the given offset of the comment text.
+func (c *checker) fixFirstParagraph(comment *ast.Comment, offset int) {
+	pos := c.fset.Position(comment.Pos())
+	src := c.sources[pos.Filename]
+	if src == nil {
+		return
+	}
+	indent := string(src[pos.Offset-(pos.Column-1) : pos.Offset])
+	e := textEdit{check: "first-paragraph", filename: pos.Filename}
+	if offset == len(comment.Text) {
+		e.start = pos.Offset + len(comment.Text)
+		e.end = e.start
+		e.newText = "\n" + indent + "//"
+	} else {
+		e.start = pos.Offset + offset
+		e.end = pos.Offset + skipSpace(comment.Text, offset)
+		e.newText = "\n" + indent + "//\n" + indent + "// "
+	}
+	c.suggestFix(e)
+}
+
+func (c *checker) checkSpacing(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		if strings.HasPrefix(comment.Text, "/*") {
+			continue
+		}
+		if isDirective(comment.Text) || c.regexp.directiveLike.MatchString(comment.Text) {
+			// Reported by the directive-format check.
+			continue
+		}
+		if comment.Text == "//" {
+			// Paragraph separator.
+			continue
+		}
+		if !strings.HasPrefix(comment.Text, "// ") && !strings.HasPrefix(comment.Text, "//\t") {
+			pos := c.fset.Position(comment.Pos())
+			c.suggestFix(textEdit{
+				check:    "spacing",
+				filename: pos.Filename,
+				start:    pos.Offset + len("//"),
+				end:      pos.Offset + len("//"),
+				newText:  " ",
+			})
+			c.warnFuncRange("spacing", comment.Pos(), comment.End(), "found comment without leading space and it's not a pragma")
+		}
+	}
+}
+
+func (c *checker) checkEndsWithPunct(doc *ast.CommentGroup) {
+	comment := lastProseLine(doc)
+	if comment == nil {
+		return
+	}
+	line := strings.TrimRight(comment.Text, " \t")
+	r, size := utf8.DecodeLastRuneInString(line)
+	if !unicode.IsPunct(r) {
+		end := comment.Pos() + token.Pos(len(line))
+		pos := c.fset.Position(end)
+		c.suggestFix(textEdit{
+			check:    "ends-with-punct",
+			filename: pos.Filename,
+			start:    pos.Offset,
+			end:      pos.Offset,
+			newText:  ".",
+		})
+		c.warnFuncRange("ends-with-punct", end-token.Pos(size), end, "doc-comment should end with punctuation, usually with period")
+	}
+}
+
+// lastProseLine returns the last line of the doc-comment text.
+// Trailing directives and empty lines are skipped.
+//
+// Nil is returned when the doc ends with a code block or a list,
+// where the punctuation is optional, and for the /**/ comments.
+func lastProseLine(doc *ast.CommentGroup) *ast.Comment {
+	for i := len(doc.List) - 1; i >= 0; i-- {
+		comment := doc.List[i]
+		body, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok {
+			return nil
+		}
+		if isDirective(comment.Text) || strings.TrimSpace(body) == "" {
+			continue
+		}
+		body = strings.TrimPrefix(body, " ")
+		if isIndented(body) || listMarkerRegexp.MatchString(body) {
+			return nil
+		}
+		return comment
+	}
+	return nil
+}
+
+func (c *checker) checkNoMultiline(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		if strings.HasPrefix(comment.Text, "/*") {
+			c.warnFuncRange("no-multiline", comment.Pos(), comment.Pos()+token.Pos(len("/*")), "should not use /**/ comments in doc-comments")
+			return
+		}
+	}
+}
+
+func (c *checker) checkBoolFuncStyle(doc *ast.CommentGroup) {
+	kind := c.boolFuncType(c.current.fn)
+	if kind == notBoolFunc || c.isPredicateExempt(c.current.fn) {
+		return
+	}
+
+	sentences := c.tokenizer.Sentences(doc.Text())
+	if len(sentences) == 0 {
+		return
+	}
+	synopsis := normalizeSpace(sentences[0].Text)
+	name := c.current.fn.Name.Name
+
+	// 1. Check if doc string has common pattern that is considered
+	// less idiomatic than proposed alternative.
+	// The pattern is only searched right after the function name,
+	// so "Foo returns true if" is reported while "Foo returns
+	// the X and whether it returns true if" is not.
+	if rest, ok := strings.CutPrefix(synopsis, name); ok && c.regexp.predAntipattern != nil {
+		loc := c.regexp.predAntipattern.FindStringIndex(rest + " ")
+		if loc != nil && loc[0] == 0 {
+			c.suggestPredicateFix(doc.List[0], name)
+			c.warnFuncRange("predicate", doc.List[0].Pos(), doc.List[0].End(), "bad predicate comment")
+			return
+		}
+	}
+
+	// 2. Guess predicate function by it's name.
+	// If it is a predicate, check doc-comment.
+	// Comma-ok functions return something else besides the bool,
+	// so they can't be described with "reports whether".
+	if kind == predicateFunc && c.regexp.predPrefix != nil && c.regexp.predPrefix.MatchString(name) {
+		if !strings.Contains(synopsis+" ", name+" reports whether ") {
+			c.warnFuncRange("predicate", doc.List[0].Pos(), doc.List[0].End(), "bad predicate comment")
+			return
+		}
+	}
+}
+
+// predicateRewriteRegexp matches the first doc line phrases that
+// can be replaced with "reports whether" without changing the meaning.
+var predicateRewriteRegexp = regexp.MustCompile(`^// (\w+) (returns true (?:if|iff|when)|(?:returns|tells|tests|checks|determines|indicates) whether) `)
+
+// suggestPredicateFix suggests the "reports whether" rewrite
+// of the predicate doc opening, if it's a known safe one.
+func (c *checker) suggestPredicateFix(first *ast.Comment, name string) {
+	m := predicateRewriteRegexp.FindStringSubmatchIndex(first.Text)
+	if m == nil || first.Text[m[2]:m[3]] != name {
+		return
+	}
+	pos := c.fset.Position(first.Pos())
+	c.suggestFix(textEdit{
+		check:    "predicate",
+		filename: pos.Filename,
+		start:    pos.Offset + m[4],
+		end:      pos.Offset + m[5],
+		newText:  "reports whether",
+	})
+}
+
+// checkNameTypo reports doc-comments that start with a word that is
+// almost the documented name: it differs only in case or by a typo.
+// These usually are the docs left stale after a rename.
+func (c *checker) checkNameTypo(d declDoc) {
+	if d.name == "" {
+		return
+	}
+	first := d.doc.List[0]
+	text, ok := strings.CutPrefix(first.Text, "// ")
+	if !ok {
+		return
+	}
+	word, _, _ := strings.Cut(text, " ")
+	word = strings.TrimRight(word, ".,:;")
+	if !isNameTypo(word, d.name) || c.declared[word] || c.words.Has(word) {
+		return
+	}
+	pos := c.fset.Position(first.Pos())
+	offset := pos.Offset + len("// ")
+	c.suggestFix(textEdit{
+		check:    "name-typo",
+		filename: pos.Filename,
+		start:    offset,
+		end:      offset + len(word),
+		newText:  d.name,
+	})
+	c.warnComment("name-typo", first, "doc-comment starts with %s, is it a stale or misspelled %s?", word, d.name)
+}
+
+// isNameTypo reports whether word is likely a misspelled name.
+// Short names can only differ in case, the longer names can have
+// one or two edits. The inflected forms, like "Gets" for Get, are
+// regular prose and are not typos, neither are the singular forms
+// of the plural names, like BroadcastInt64 for BroadcastInt64s.
+func isNameTypo(word, name string) bool {
+	if word == name || !isIdent(word) {
+		return false
+	}
+	if strings.EqualFold(word, name) {
+		return true
+	}
+	for _, suffix := range []string{"s", "es", "d", "ed", "ing"} {
+		if word == name+suffix {
+			return false
+		}
+	}
+	for _, suffix := range []string{"s", "es"} {
+		if name == word+suffix {
+			return false
+		}
+	}
+	maxDist := 0
+	switch {
+	case len(name) >= 8:
+		maxDist = 2
+	case len(name) >= 4:
+		maxDist = 1
+	}
+	return editDistance(word, name) <= maxDist
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkThisOpening reports doc-comments that start with "This function"
+// and alike instead of the documented name.
+func (c *checker) checkThisOpening(d declDoc) {
+	if d.name == "" {
+		return
+	}
+	first := d.doc.List[0]
+	text, ok := strings.CutPrefix(first.Text, "// ")
+	if !ok {
+		return
+	}
+	loc := c.regexp.thisOpening.FindStringIndex(text)
+	if loc == nil {
+		return
+	}
+	phrase := text[loc[0]:loc[1]]
+	pos := c.fset.Position(first.Pos())
+	offset := pos.Offset + len("// ")
+	c.suggestFix(textEdit{
+		check:    "this-opening",
+		filename: pos.Filename,
+		start:    offset + loc[0],
+		end:      offset + loc[1],
+		newText:  d.name,
+	})
+	c.warnComment("this-opening", first, "doc-comment should start with %s instead of %q", d.name, phrase)
+}
+
+// isPredicateExempt reports whether the method is a well-known
+// interface method implementation, like sort.Interface Less.
+// Their docs usually describe the ordering or the matching
+// rules instead of following the predicate style.
+func (c *checker) isPredicateExempt(decl *ast.FuncDecl) bool {
+	if decl.Recv == nil {
+		return false
+	}
+	sig := strings.ReplaceAll(funcSignatureString(decl), " ", "")
+	for _, exempt := range c.predicateExempt {
+		exempt = strings.ReplaceAll(exempt, " ", "")
+		if exempt == decl.Name.Name || exempt == sig {
+			return true
+		}
+	}
+	return false
+}
+
+// funcSignatureString returns a function signature in the
+// "Name(params) results" form, e.g. "Less(int, int) bool".
+func funcSignatureString(decl *ast.FuncDecl) string {
+	fieldTypes := func(list *ast.FieldList) []string {
+		var typs []string
+		if list == nil {
+			return nil
+		}
+		for _, field := range list.List {
+			typ := types.ExprString(field.Type)
+			typs = append(typs, typ)
+			for i := 1; i < len(field.Names); i++ {
+				typs = append(typs, typ)
+			}
+		}
+		return typs
+	}
+
+	sig := decl.Name.Name + "(" + strings.Join(fieldTypes(decl.Type.Params), ", ") + ")"
+	switch results := fieldTypes(decl.Type.Results); len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// boolFuncKind classifies functions by their bool results.
+type boolFuncKind int
+
+const (
+	// notBoolFunc is a function without a trailing bool result.
+	notBoolFunc boolFuncKind = iota
+
+	// predicateFunc is a function with a single bool result,
+	// like "func HasX() bool" or "func HasX() (ok bool)".
+	predicateFunc
+
+	// commaOkFunc is a function with several results where
+	// the last one is bool, like "func Lookup(k string) (T, bool)".
+	commaOkFunc
+)
+
+func (c *checker) boolFuncType(decl *ast.FuncDecl) boolFuncKind {
+	if sig := c.funcSignature(decl); sig != nil {
+		results := sig.Results()
+		switch {
+		case results.Len() == 0 || !isBoolType(results.At(results.Len()-1).Type()):
+			return notBoolFunc
+		case results.Len() == 1:
+			return predicateFunc
+		default:
+			return commaOkFunc
+		}
+	}
+
+	if decl.Type.Results == nil || len(decl.Type.Results.List) == 0 {
+		return notBoolFunc
+	}
+	results := decl.Type.Results.List
+	last := results[len(results)-1]
+	if typ, ok := last.Type.(*ast.Ident); !ok || typ.Name != "bool" {
+		return notBoolFunc
+	}
+	if decl.Type.Results.NumFields() == 1 {
+		return predicateFunc
+	}
+	return commaOkFunc
+}
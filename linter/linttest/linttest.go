@@ -0,0 +1,40 @@
+// Package linttest runs the doccheck checks over the annotated fixtures,
+// in the style of the analysistest package.
+//
+// A fixture is a directory of Go files, every subdirectory is a package.
+// The expected diagnostics are the "// want" annotations at the end of
+// the lines, see linter.CheckFixtures:
+//
+//	// IsOK tells whether it's ok. // want "bad predicate comment"
+//	func IsOK() bool
+//
+// A test of the checks or a plugin check is then
+//
+//	func TestChecks(t *testing.T) {
+//		linttest.Run(t, "testdata", linter.Options{})
+//	}
+package linttest
+
+import (
+	"github.com/Quasilyte/doccheck/linter"
+)
+
+// Testing is the part of testing.TB used by Run.
+type Testing interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Run checks the fixtures of the dir with the options and reports
+// the unexpected and missing diagnostics as the test errors.
+func Run(t Testing, dir string, opts linter.Options) {
+	t.Helper()
+	mismatches, err := linter.CheckFixtures(dir, opts)
+	if err != nil {
+		t.Fatalf("check %s: %v", dir, err)
+	}
+	for _, m := range mismatches {
+		t.Errorf("%s", m)
+	}
+}
@@ -0,0 +1,80 @@
+package linttest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Quasilyte/doccheck/linter"
+)
+
+// recorder is a Testing that keeps the errors instead of failing the test.
+type recorder struct {
+	errors []string
+	fatal  string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.fatal = fmt.Sprintf(format, args...)
+}
+
+func TestRunMismatches(t *testing.T) {
+	dir := t.TempDir()
+	src := `// Package fixture is a fixture.
+package fixture
+
+// IsOK tells whether it's ok.
+func IsOK() bool { return true }
+
+// Parse parses the config. // want "should end with punctuation"
+func Parse() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "fixture.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var r recorder
+	Run(&r, dir, linter.Options{})
+	if r.fatal != "" {
+		t.Fatalf("Run failed: %s", r.fatal)
+	}
+	want := []string{
+		"fixture.go:4: unexpected diagnostic: bad predicate comment",
+		`fixture.go:7: no diagnostic matching "should end with punctuation"`,
+	}
+	if len(r.errors) != len(want) {
+		t.Fatalf("Run reported %d errors, want %d:\n%s", len(r.errors), len(want), strings.Join(r.errors, "\n"))
+	}
+	for i, w := range want {
+		if !strings.Contains(r.errors[i], w) {
+			t.Errorf("error %d:\nhave %s\nwant %s", i, r.errors[i], w)
+		}
+	}
+}
+
+func TestRunMatches(t *testing.T) {
+	dir := t.TempDir()
+	src := `// Package fixture is a fixture.
+package fixture
+
+// IsOK tells whether it's ok. // want "bad predicate comment"
+func IsOK() bool { return true }
+`
+	if err := os.WriteFile(filepath.Join(dir, "fixture.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var r recorder
+	Run(&r, dir, linter.Options{})
+	if r.fatal != "" || len(r.errors) != 0 {
+		t.Errorf("Run reported the mismatches of the matching fixture:\n%s%s", r.fatal, strings.Join(r.errors, "\n"))
+	}
+}
@@ -0,0 +1,120 @@
+package linter
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// defaultLanguage is the language of the messages in the source code.
+// It has no catalog, the messages are used as they are.
+const defaultLanguage = "en"
+
+//go:embed locales
+var localeFiles embed.FS
+
+// catalog is a translation of the messages, the check descriptions
+// and the explain texts. The missing translations fall back to English.
+type catalog struct {
+	// Messages map the English message formats, like "%s should use https",
+	// to the translated ones. The translations may reorder the arguments
+	// with the explicit argument indexes, like %[2]s.
+	Messages map[string]string `json:"messages"`
+
+	// Checks are the translated check docs by the check name.
+	Checks map[string]checkTranslation `json:"checks"`
+}
+
+// checkTranslation is a translation of the checkList description
+// and the explanation texts. The examples are Go code, so they are
+// not translated.
+type checkTranslation struct {
+	Description string `json:"description"`
+	Rationale   string `json:"rationale"`
+	Disable     string `json:"disable"`
+}
+
+// withLanguage returns the config with the language overridden
+// by the DOCCHECK_LANG variable, if it's set.
+func (l *linter) withLanguage(conf *Config) *Config {
+	if l.languageOverride == "" || l.languageOverride == conf.Language {
+		return conf
+	}
+	withLang := *conf
+	withLang.Language = l.languageOverride
+	return &withLang
+}
+
+// languages returns the supported languages.
+func languages() []string {
+	langs := []string{defaultLanguage}
+	files, _ := fs.Glob(localeFiles, "locales/*.json")
+	for _, filename := range files {
+		langs = append(langs, strings.TrimSuffix(path.Base(filename), ".json"))
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// loadCatalog returns the catalog of the language, or nil for English.
+// The language is either a code like "ru" or a locale name like
+// "ru_RU.UTF-8", the locales fall back to their language catalogs.
+func loadCatalog(lang string) (*catalog, error) {
+	lang, _, _ = strings.Cut(lang, ".")
+	lang, _, _ = strings.Cut(lang, "@")
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+	base, _, _ := strings.Cut(lang, "-")
+	if lang == "" || base == defaultLanguage {
+		return nil, nil
+	}
+	for _, name := range []string{lang, base} {
+		data, err := localeFiles.ReadFile("locales/" + name + ".json")
+		if err != nil {
+			continue
+		}
+		cat := &catalog{}
+		if err := json.Unmarshal(data, cat); err != nil {
+			return nil, fmt.Errorf("%s catalog: %v", name, err)
+		}
+		return cat, nil
+	}
+	return nil, fmt.Errorf("unknown language %q (expected one of %s)", lang, strings.Join(languages(), ", "))
+}
+
+// sprintf formats the message with its translated format, if there is one.
+func (cat *catalog) sprintf(format string, args ...interface{}) string {
+	if cat != nil {
+		if translated := cat.Messages[format]; translated != "" {
+			format = translated
+		}
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// description returns the translated check description.
+func (cat *catalog) description(info *checkInfo) string {
+	if cat != nil && cat.Checks[info.name].Description != "" {
+		return cat.Checks[info.name].Description
+	}
+	return info.description
+}
+
+// explanation returns the check explanation with the translated texts.
+func (cat *catalog) explanation(name string) explanation {
+	e := explanations[name]
+	if cat == nil {
+		return e
+	}
+	t := cat.Checks[name]
+	if t.Rationale != "" {
+		e.rationale = t.Rationale
+	}
+	if t.Disable != "" {
+		e.disable = t.Disable
+	}
+	return e
+}
@@ -0,0 +1,294 @@
+package linter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"unicode/utf8"
+)
+
+// lspServer is a minimal Language Server that publishes
+// the diagnostics when a document is opened or saved.
+//
+// Only the file-level checks are executed, as the documents
+// are checked from the editor buffers one at a time.
+type lspServer struct {
+	l   *linter
+	out io.Writer
+
+	// docs maps document URIs to their current contents.
+	docs map[string][]byte
+
+	shutdown bool
+}
+
+type lspMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  any              `json:"result,omitempty"`
+	Error   *lspError        `json:"error,omitempty"`
+}
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+const lspMethodNotFound = -32601
+
+type lspTextDocument struct {
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+}
+
+type lspDocumentParams struct {
+	TextDocument   lspTextDocument `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Code     string   `json:"code"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+// errLSPExit is returned when the client asks the server to exit.
+var errLSPExit = errors.New("exit")
+
+// serveLSP runs the Language Server over the given streams
+// until the client sends the exit notification.
+func (l *linter) serveLSP(in io.Reader, out io.Writer) error {
+	s := &lspServer{l: l, out: out, docs: make(map[string][]byte)}
+	r := textproto.NewReader(bufio.NewReader(in))
+	for {
+		msg, err := readLSPMessage(r)
+		if err != nil {
+			return err
+		}
+		err = s.handle(msg)
+		if err == errLSPExit {
+			if !s.shutdown {
+				return errors.New("exit without shutdown")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *lspServer) handle(msg *lspMessage) error {
+	s.l.logger.Debug("lsp message", "method", msg.Method)
+	switch msg.Method {
+	case "initialize":
+		return s.reply(msg, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{
+					"openClose": true,
+					"change":    1, // Full document sync.
+					"save":      map[string]any{"includeText": false},
+				},
+			},
+			"serverInfo": map[string]any{"name": "doccheck"},
+		})
+	case "shutdown":
+		s.shutdown = true
+		return s.reply(msg, nil)
+	case "exit":
+		return errLSPExit
+	}
+
+	if msg.ID != nil {
+		return s.write(&lspMessage{
+			JSONRPC: "2.0",
+			ID:      msg.ID,
+			Error:   &lspError{Code: lspMethodNotFound, Message: "method not supported: " + msg.Method},
+		})
+	}
+
+	var params lspDocumentParams
+	switch msg.Method {
+	case "textDocument/didOpen", "textDocument/didChange", "textDocument/didSave", "textDocument/didClose":
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return fmt.Errorf("%s: %v", msg.Method, err)
+		}
+	}
+	uri := params.TextDocument.URI
+	switch msg.Method {
+	case "textDocument/didOpen":
+		s.docs[uri] = []byte(params.TextDocument.Text)
+		return s.publish(uri)
+	case "textDocument/didChange":
+		if n := len(params.ContentChanges); n != 0 {
+			s.docs[uri] = []byte(params.ContentChanges[n-1].Text)
+		}
+	case "textDocument/didSave":
+		return s.publish(uri)
+	case "textDocument/didClose":
+		delete(s.docs, uri)
+		return s.notify("textDocument/publishDiagnostics", map[string]any{
+			"uri":         uri,
+			"diagnostics": []lspDiagnostic{},
+		})
+	}
+	// Other notifications are ignored.
+	return nil
+}
+
+// publish checks the document and sends its diagnostics.
+func (s *lspServer) publish(uri string) error {
+	src, ok := s.docs[uri]
+	if !ok {
+		return nil
+	}
+	filename := uri
+	if u, err := url.Parse(uri); err == nil && u.Scheme == "file" {
+		filename = filepath.FromSlash(u.Path)
+	}
+
+	diagnostics := []lspDiagnostic{}
+	s.l.sink = func(iss issue, sev severity) {
+		diagnostics = append(diagnostics, lspDiagnostic{
+			Range:    lspIssueRange(src, iss.Pos, iss.End),
+			Severity: lspSeverity(sev),
+			Code:     iss.Check,
+			Source:   "doccheck",
+			Message:  iss.Message,
+		})
+	}
+	// Every check uses a fresh file set, so the long sessions
+	// don't accumulate all versions of the documents.
+	s.l.fset = token.NewFileSet()
+	s.l.checkSource(filename, src)
+	s.l.sink = nil
+
+	return s.notify("textDocument/publishDiagnostics", map[string]any{
+		"uri":         uri,
+		"diagnostics": diagnostics,
+	})
+}
+
+// lspIssueRange returns the issue range. Issues without
+// the end position span till the end of the line.
+func lspIssueRange(src []byte, pos, end token.Position) lspRange {
+	if pos.Line == 0 {
+		return lspRange{}
+	}
+	if end.Line == 0 {
+		end = pos
+		end.Offset = min(pos.Offset, len(src))
+		if i := bytes.IndexByte(src[end.Offset:], '\n'); i >= 0 {
+			end.Offset += i
+		} else {
+			end.Offset = len(src)
+		}
+	}
+	return lspRange{
+		Start: lspOffsetPosition(src, pos.Line, pos.Offset),
+		End:   lspOffsetPosition(src, end.Line, end.Offset),
+	}
+}
+
+// lspOffsetPosition converts the byte offset on the given 1-based line
+// to the LSP position. LSP characters are UTF-16 code units.
+func lspOffsetPosition(src []byte, line, offset int) lspPosition {
+	offset = min(offset, len(src))
+	lineStart := bytes.LastIndexByte(src[:offset], '\n') + 1
+	return lspPosition{Line: line - 1, Character: utf16Len(src[lineStart:offset])}
+}
+
+func utf16Len(b []byte) int {
+	n := 0
+	for len(b) != 0 {
+		r, size := utf8.DecodeRune(b)
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+		b = b[size:]
+	}
+	return n
+}
+
+func lspSeverity(sev severity) int {
+	switch sev {
+	case severityError:
+		return 1
+	case severityWarning:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func (s *lspServer) reply(req *lspMessage, result any) error {
+	if result == nil {
+		// Result is required in the successful responses.
+		result = json.RawMessage("null")
+	}
+	return s.write(&lspMessage{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func (s *lspServer) notify(method string, params any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return s.write(&lspMessage{JSONRPC: "2.0", Method: method, Params: data})
+}
+
+func (s *lspServer) write(msg *lspMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	return err
+}
+
+// readLSPMessage reads a single base protocol message.
+func readLSPMessage(r *textproto.Reader) (*lspMessage, error) {
+	header, err := r.ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, fmt.Errorf("bad Content-Length: %v", err)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.R, data); err != nil {
+		return nil, err
+	}
+	var msg lspMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
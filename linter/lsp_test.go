@@ -0,0 +1,134 @@
+package linter
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io"
+	"log/slog"
+	"net/textproto"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestServeLSP(t *testing.T) {
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- l.serveLSP(inR, outW)
+		outW.Close()
+	}()
+	r := textproto.NewReader(bufio.NewReader(outR))
+
+	// frame returns the message with the base protocol header.
+	frame := func(msg map[string]any) []byte {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Appendf(nil, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	}
+	send := func(msgs ...map[string]any) {
+		// The messages are sent in a single write,
+		// so the server must split them by the lengths.
+		var data []byte
+		for _, msg := range msgs {
+			data = append(data, frame(msg)...)
+		}
+		if _, err := inW.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	receive := func() *lspMessage {
+		msg, err := readLSPMessage(r)
+		if err != nil {
+			t.Fatalf("read the server message: %v", err)
+		}
+		return msg
+	}
+	// receiveDiagnostics returns the check names of the published diagnostics.
+	receiveDiagnostics := func(uri string) []string {
+		msg := receive()
+		if msg.Method != "textDocument/publishDiagnostics" {
+			t.Fatalf("have %q message, want textDocument/publishDiagnostics", msg.Method)
+		}
+		var params struct {
+			URI         string          `json:"uri"`
+			Diagnostics []lspDiagnostic `json:"diagnostics"`
+		}
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			t.Fatal(err)
+		}
+		if params.URI != uri {
+			t.Errorf("diagnostics URI: have %q, want %q", params.URI, uri)
+		}
+		var checks []string
+		for _, d := range params.Diagnostics {
+			checks = append(checks, d.Code)
+		}
+		return checks
+	}
+
+	send(map[string]any{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": map[string]any{}})
+	if msg := receive(); msg.ID == nil || string(*msg.ID) != "1" || msg.Error != nil || msg.Result == nil {
+		t.Fatalf("initialize response: %+v", msg)
+	}
+
+	uri := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "p.go"))
+	// The non-ASCII text checks that Content-Length counts the bytes.
+	bad := "// Package p is a test.\npackage p\n\n// Foo says привет\nfunc Foo() {}\n"
+	good := "// Package p is a test.\npackage p\n\n// Foo says привет.\nfunc Foo() {}\n"
+	textDocument := func(method string, params map[string]any) map[string]any {
+		return map[string]any{"jsonrpc": "2.0", "method": method, "params": params}
+	}
+
+	send(textDocument("textDocument/didOpen", map[string]any{
+		"textDocument": map[string]any{"uri": uri, "languageId": "go", "version": 1, "text": bad},
+	}))
+	if checks := receiveDiagnostics(uri); !slices.Contains(checks, "ends-with-punct") {
+		t.Errorf("didOpen diagnostics: have %v, want ends-with-punct", checks)
+	}
+
+	// didChange doesn't publish the diagnostics, didSave checks
+	// the last changed contents.
+	send(
+		textDocument("textDocument/didChange", map[string]any{
+			"textDocument":   map[string]any{"uri": uri, "version": 2},
+			"contentChanges": []map[string]any{{"text": bad}, {"text": good}},
+		}),
+		textDocument("textDocument/didSave", map[string]any{
+			"textDocument": map[string]any{"uri": uri},
+		}),
+	)
+	if checks := receiveDiagnostics(uri); len(checks) != 0 {
+		t.Errorf("didChange+didSave diagnostics: have %v, want none", checks)
+	}
+
+	send(map[string]any{"jsonrpc": "2.0", "id": 2, "method": "textDocument/hover", "params": map[string]any{}})
+	if msg := receive(); msg.Error == nil || msg.Error.Code != lspMethodNotFound {
+		t.Errorf("unsupported request response: %+v", msg)
+	}
+
+	send(
+		map[string]any{"jsonrpc": "2.0", "id": 3, "method": "shutdown"},
+		map[string]any{"jsonrpc": "2.0", "method": "exit"},
+	)
+	if msg := receive(); msg.ID == nil || string(*msg.ID) != "3" || msg.Error != nil {
+		t.Errorf("shutdown response: %+v", msg)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("serveLSP: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+var (
+	// codeSpanRegexp matches the backquoted code spans. Double backquotes
+	// are the go/doc way to write the quotes, so they're skipped.
+	codeSpanRegexp = regexp.MustCompile("(?:^|[^`])`([^`\\s][^`]*)`(?:[^`]|$)")
+
+	// boldRegexp matches the bold text. It must start and end with
+	// a word character, so the globs like "**/*.go" are not matched.
+	boldRegexp = regexp.MustCompile(`\*\*(\w(?:[^*]*\w)?)\*\*`)
+
+	// headingRegexp matches the Markdown headings of any level.
+	headingRegexp = regexp.MustCompile(`^(#+) \S`)
+
+	identRegexp = regexp.MustCompile(`^[A-Za-z_]\w*(?:\.\w+)?$`)
+)
+
+// checkMarkdown reports the Markdown syntax go/doc renders verbatim:
+// code spans, bold text, multi-level headings and the headings that are
+// not separate paragraphs. Markdown links are reported by the url check,
+// and the fenced code blocks by the code-block one.
+func (c *checker) checkMarkdown(doc *ast.CommentGroup) {
+	var lines []docLine
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			return
+		}
+		if isDirective(comment.Text) {
+			continue
+		}
+		text := strings.TrimPrefix(comment.Text[len("//"):], " ")
+		lines = append(lines, docLine{text: text, comment: comment})
+	}
+
+	// Code spans are a valid identifier style in the quoted mode.
+	codeSpans := c.identStyle == "" || c.identStyle == "plain" || c.identStyle == "link"
+	blank := func(i int) bool {
+		return i < 0 || i >= len(lines) || strings.TrimSpace(lines[i].text) == ""
+	}
+
+	fenced := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line.text), "```") {
+			fenced = !fenced
+			continue
+		}
+		if fenced || isIndented(line.text) {
+			continue
+		}
+
+		if m := headingRegexp.FindStringSubmatch(line.text); m != nil {
+			switch {
+			case len(m[1]) > 1:
+				c.warnComment("markdown", line.comment, "%s headings are not supported by go/doc, use a single # heading", m[1])
+			case !blank(i-1) || !blank(i+1):
+				c.warnComment("markdown", line.comment, "heading is rendered as a text unless it's surrounded by empty lines")
+			}
+		}
+		if m := boldRegexp.FindStringSubmatchIndex(line.text); m != nil {
+			c.fixBold(line)
+			c.warnComment("markdown", line.comment, "**%s** is rendered verbatim, use plain text", line.text[m[2]:m[3]])
+		}
+		if m := codeSpanRegexp.FindStringSubmatch(line.text); m != nil && codeSpans {
+			if identRegexp.MatchString(m[1]) {
+				c.warnComment("markdown", line.comment, "`%s` is rendered verbatim, use [%s] doc link or the plain name", m[1], m[1])
+			} else {
+				c.warnComment("markdown", line.comment, "`%s` is rendered verbatim, indent the code to render it as a code block", m[1])
+			}
+		}
+	}
+}
+
+// fixBold removes the bold markers of the doc line.
+func (c *checker) fixBold(line docLine) {
+	pos := c.fset.Position(line.comment.Pos())
+	offset := len(line.comment.Text) - len(line.text)
+	for _, m := range boldRegexp.FindAllStringSubmatchIndex(line.text, -1) {
+		c.suggestFix(textEdit{
+			check:    "markdown",
+			filename: pos.Filename,
+			start:    pos.Offset + offset + m[0],
+			end:      pos.Offset + offset + m[1],
+			newText:  line.text[m[2]:m[3]],
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// messageData is the data of the message templates.
+type messageData struct {
+	// Message is the original issue message.
+	Message string
+
+	// Rule is the check name.
+	Rule string
+
+	// Name is the documented identifier, if known.
+	Name string
+
+	Severity string
+	File     string
+	Line     int
+	Column   int
+}
+
+// compileMessages parses the per-check message templates.
+// Every template is executed once with the empty data,
+// so the references to unknown fields are reported early.
+func (s *settings) compileMessages(messages map[string]string) (map[string]*template.Template, error) {
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	templates := make(map[string]*template.Template, len(messages))
+	for _, name := range sortedKeys(messages) {
+		if _, ok := s.severities[name]; !ok {
+			return nil, fmt.Errorf("messages: unknown check %q (expected one of %s)", name, strings.Join(s.checkNames(), ", "))
+		}
+		t, err := template.New(name).Parse(messages[name])
+		if err != nil {
+			return nil, fmt.Errorf("messages: %v", err)
+		}
+		if err := t.Execute(io.Discard, messageData{}); err != nil {
+			return nil, fmt.Errorf("messages: %v", err)
+		}
+		templates[name] = t
+	}
+	return templates, nil
+}
+
+// formatMessage returns the issue message rendered with
+// the check message template, if there is one.
+func (c *checker) formatMessage(iss issue, sev severity) string {
+	t := c.messages[iss.Check]
+	if t == nil {
+		return iss.Message
+	}
+	var sb strings.Builder
+	err := t.Execute(&sb, messageData{
+		Message:  iss.Message,
+		Rule:     iss.Check,
+		Name:     iss.Name,
+		Severity: sev.String(),
+		File:     iss.Pos.Filename,
+		Line:     iss.Pos.Line,
+		Column:   iss.Pos.Column,
+	})
+	if err != nil {
+		c.logger.Warn("message template failed", "check", iss.Check, "err", err)
+		return iss.Message
+	}
+	return sb.String()
+}
@@ -0,0 +1,100 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// selfRefRegexp matches the receiver references borrowed from other languages.
+var selfRefRegexp = regexp.MustCompile(`\b(this|self)\.\w+`)
+
+// checkMethodDoc checks the method doc-comments, which should start
+// with the method name. Starting with the receiver type name, like
+// "Buffer.Len returns" or "Buffer returns", makes the method docs
+// inconsistent with go/doc that lists methods under their types.
+func (c *checker) checkMethodDoc(doc *ast.CommentGroup) {
+	fn := c.current.fn
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return
+	}
+	recvField := fn.Recv.List[0]
+	recvType := recvTypeName(recvField.Type)
+	if recvType == "" {
+		return
+	}
+
+	word, _, _ := strings.Cut(strings.TrimSpace(doc.Text()), " ")
+	word = strings.TrimPrefix(word, "*")
+	// The method can share the receiver type name, like Error.Error.
+	if word != fn.Name.Name && (word == recvType || word == recvType+"."+fn.Name.Name) {
+		c.warnFuncRange("method-doc", doc.List[0].Pos(), doc.List[0].End(), "method doc-comment should start with the method name %s, not %s",
+			fn.Name.Name, word)
+	}
+
+	if !c.receiverName || len(recvField.Names) == 0 || recvField.Names[0].Name == "_" {
+		return
+	}
+	recvName := recvField.Names[0].Name
+	for _, comment := range doc.List {
+		m := selfRefRegexp.FindStringSubmatch(comment.Text)
+		if m == nil {
+			continue
+		}
+		c.warnComment("receiver-name", comment, "refer to the receiver as %s instead of %s",
+			recvName, m[1])
+	}
+}
+
+// checkMethodDocs reports the exported types with undocumented
+// exported methods. All such methods of a type are reported as
+// a single issue, positioned at the type declaration.
+//
+// The types and methods declared in the files for different build
+// tags are counted once, at their first declaration, and a method
+// is documented if any of its declarations is.
+func (c *checker) checkMethodDocs(pkg *ast.Package) {
+	types := make(map[string]*ast.TypeSpec)
+	var typeNames []string
+	methods := make(map[string][]string)
+	documented := make(map[string]bool)
+	for _, f := range sortedFiles(pkg) {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					spec, ok := spec.(*ast.TypeSpec)
+					if !ok || !spec.Name.IsExported() || types[spec.Name.Name] != nil {
+						continue
+					}
+					types[spec.Name.Name] = spec
+					typeNames = append(typeNames, spec.Name.Name)
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) == 0 || !decl.Name.IsExported() {
+					continue
+				}
+				recvType := recvTypeName(decl.Recv.List[0].Type)
+				key := recvType + "." + decl.Name.Name
+				if _, ok := documented[key]; !ok {
+					methods[recvType] = append(methods[recvType], decl.Name.Name)
+				}
+				documented[key] = documented[key] || decl.Doc != nil
+			}
+		}
+	}
+
+	for _, name := range typeNames {
+		var undocumented []string
+		for _, method := range methods[name] {
+			if !documented[name+"."+method] {
+				undocumented = append(undocumented, method)
+			}
+		}
+		if len(undocumented) == 0 {
+			continue
+		}
+		c.warnIdent("method-docs", types[name].Name, "type %s: %d of %d methods undocumented: %s",
+			name, len(undocumented), len(methods[name]), strings.Join(undocumented, ", "))
+	}
+}
@@ -0,0 +1,49 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+var (
+	// bugNoteRegexp matches the well-formed "BUG(owner): text" notes.
+	bugNoteRegexp = regexp.MustCompile(`^BUG\([^()\s]+\): \S`)
+
+	// bugNoteLikeRegexp matches the comments that were likely meant
+	// to be BUG notes, like "BUG: text" or "bug(owner) text".
+	bugNoteLikeRegexp = regexp.MustCompile(`(?i)^bug\s*[(:]`)
+)
+
+// checkBugNotes checks the BUG notes are in the "BUG(owner): text"
+// form and are separate comment groups. Malformed notes don't
+// show up in the Bugs section of the package docs, and the notes
+// attached to declarations become a part of their docs.
+func (c *checker) checkBugNotes(f *ast.File) {
+	docs := make(map[*ast.CommentGroup]bool)
+	if f.Doc != nil {
+		docs[f.Doc] = true
+	}
+	for _, d := range fileDeclDocs(f) {
+		docs[d.doc] = true
+	}
+
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			text, ok := strings.CutPrefix(comment.Text, "//")
+			if !ok {
+				continue
+			}
+			text = strings.TrimLeft(text, " \t")
+			if !bugNoteLikeRegexp.MatchString(text) {
+				continue
+			}
+			switch {
+			case !bugNoteRegexp.MatchString(text):
+				c.warnComment("bug-note", comment, "BUG note should be in the \"BUG(owner): text\" form")
+			case docs[group]:
+				c.warnComment("bug-note", comment, "BUG note is a part of the doc-comment, separate it with an empty line")
+			}
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package linter
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// ANSI escape sequences used by the colored output.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiBlue   = "\x1b[34m"
+	ansiGray   = "\x1b[90m"
+)
+
+// outputFormats are the -format values.
+var outputFormats = []string{"text", "grouped", "html", "json", "sarif", "github", "codeclimate", "rdjson", "teamcity"}
+
+// issuePrinter writes the issues flushed by Flush.
+type issuePrinter interface {
+	Print(d Diagnostic)
+}
+
+// textPrinter writes the issues in the text format:
+//
+//	file.go:10:1: warning: message (DC001 check)
+//
+// In the grouped mode, every file is printed once as a header
+// followed by its issues, which must be ordered by the file:
+//
+//	file.go
+//	  10:1     warning  message  DC001 check
+type textPrinter struct {
+	w       io.Writer
+	color   bool
+	grouped bool
+
+	// file is the current group header.
+	file string
+}
+
+// Print writes a single issue line.
+func (p *textPrinter) Print(d Diagnostic) {
+	if p.grouped {
+		p.printGrouped(d)
+		return
+	}
+	if !p.color {
+		fmt.Fprintf(p.w, "%s: %s: %s (%s)\n", d.Pos, d.Severity, d.Message, checkLabel(d.Check))
+		return
+	}
+	fmt.Fprintf(p.w, "%s%s%s: %s%s%s: %s %s(%s)%s\n",
+		ansiBold, d.Pos, ansiReset,
+		severityColor(d.severity()), d.Severity, ansiReset,
+		d.Message,
+		ansiGray, checkLabel(d.Check), ansiReset)
+}
+
+// reportedIssue is an issue waiting to be printed.
+type reportedIssue struct {
+	iss issue
+	sev severity
+}
+
+// Flush prints the pending issues ordered by their position and check,
+// so the output doesn't depend on the order the packages are checked in.
+// Issues over the -max-issues limit are only counted as suppressed.
+func (l *linter) Flush() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	slices.SortFunc(l.pending, func(a, b reportedIssue) int {
+		return cmp.Or(
+			cmp.Compare(a.iss.Pos.Filename, b.iss.Pos.Filename),
+			cmp.Compare(a.iss.Pos.Line, b.iss.Pos.Line),
+			cmp.Compare(a.iss.Pos.Column, b.iss.Pos.Column),
+			cmp.Compare(a.iss.Check, b.iss.Check),
+			cmp.Compare(a.iss.Message, b.iss.Message),
+		)
+	})
+	for _, r := range l.pending {
+		if l.maxIssues > 0 && l.printed >= l.maxIssues {
+			l.suppressed++
+			continue
+		}
+		l.printed++
+		l.printer.Print(r.iss.diagnostic(r.sev))
+	}
+	l.pending = l.pending[:0]
+}
+
+func (p *textPrinter) printGrouped(d Diagnostic) {
+	if d.Pos.Filename != p.file {
+		if p.file != "" {
+			fmt.Fprintln(p.w)
+		}
+		p.file = d.Pos.Filename
+		p.colored(ansiBold, p.file)
+		fmt.Fprintln(p.w)
+	}
+	pos := "-"
+	if d.Pos.Line != 0 {
+		pos = fmt.Sprintf("%d:%d", d.Pos.Line, d.Pos.Column)
+	}
+	fmt.Fprintf(p.w, "  %-8s ", pos)
+	p.colored(severityColor(d.severity()), fmt.Sprintf("%-7s", d.Severity))
+	fmt.Fprintf(p.w, "  %s  ", d.Message)
+	p.colored(ansiGray, checkLabel(d.Check))
+	fmt.Fprintln(p.w)
+}
+
+// colored writes s in the given ANSI color if the colors are enabled.
+func (p *textPrinter) colored(color, s string) {
+	if p.color {
+		fmt.Fprint(p.w, color+s+ansiReset)
+	} else {
+		fmt.Fprint(p.w, s)
+	}
+}
+
+func severityColor(sev severity) string {
+	switch sev {
+	case severityError:
+		return ansiRed
+	case severityWarning:
+		return ansiYellow
+	default:
+		return ansiBlue
+	}
+}
+
+// useColor resolves the -color mode for the output file.
+// In "auto" mode, the output is colored if it's a terminal
+// and neither NO_COLOR nor TERM=dumb are set.
+func useColor(mode string, f *os.File) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+			return false, nil
+		}
+		info, err := f.Stat()
+		return err == nil && info.Mode()&os.ModeCharDevice != 0, nil
+	default:
+		return false, fmt.Errorf("unknown mode %q, want always, never or auto", mode)
+	}
+}
@@ -0,0 +1,120 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	panicWordRegexp = regexp.MustCompile(`(?i)\bpanic(s|ked|king)?\b`)
+
+	// unreachableRegexp matches the comments and the panic messages
+	// that mark the panics as the "impossible" assertions, which are
+	// not a part of the function contract.
+	unreachableRegexp = regexp.MustCompile(`(?i)\b(?:unreachable|impossible|not reached|can(?:no|')?t happen|(?:should|must|will) never happen|(?:should|must)(?: not|n't) happen|never happens|bug|internal error)\b`)
+)
+
+// checkPanicDoc checks that the functions that panic say so
+// in their docs. These are the MustX functions and the exported
+// functions with the reachable panic calls, see findPanics.
+// All panic lines are reported, so the doc can describe each case.
+func (c *checker) checkPanicDoc(f *ast.File, doc *ast.CommentGroup) {
+	fn := c.current.fn
+	name := fn.Name.Name
+	suffix, ok := strings.CutPrefix(name, "Must")
+	isMust := ok && (suffix == "" || unicode.IsUpper(rune(suffix[0])))
+	var calls []*ast.CallExpr
+	if !isMust && ast.IsExported(name) {
+		calls = c.findPanics(f, fn.Body)
+	}
+	if !isMust && len(calls) == 0 {
+		return
+	}
+	if panicWordRegexp.MatchString(doc.Text()) {
+		return
+	}
+	switch {
+	case isMust:
+		c.warnFunc("panic-doc", "%s doc-comment should describe when it panics", name)
+	case len(calls) == 1:
+		c.warnFunc("panic-doc", "%s panics at line %d, its doc-comment should describe when", name, c.fset.Position(calls[0].Pos()).Line)
+	default:
+		lines := make([]string, len(calls))
+		for i, call := range calls {
+			lines[i] = strconv.Itoa(c.fset.Position(call.Pos()).Line)
+		}
+		c.warnFunc("panic-doc", "%s panics at lines %s, its doc-comment should describe when", name, strings.Join(lines, ", "))
+	}
+}
+
+// findPanics returns the panic calls of the function body in the source
+// order. The panics of the function literals are skipped, since they
+// happen only when the literal is called.
+//
+// The panics are the "impossible" assertions and are skipped too
+// if their message or a comment on the same or the preceding line
+// says so, like "unreachable" or "can't happen".
+func (c *checker) findPanics(f *ast.File, body *ast.BlockStmt) []*ast.CallExpr {
+	if body == nil {
+		return nil
+	}
+
+	// Lines of the function comments marking the unreachable code.
+	marked := make(map[int]bool)
+	for _, group := range f.Comments {
+		if group.End() < body.Pos() || group.Pos() > body.End() {
+			continue
+		}
+		for _, comment := range group.List {
+			if unreachableRegexp.MatchString(comment.Text) {
+				marked[c.fset.Position(comment.End()).Line] = true
+			}
+		}
+	}
+
+	var found []*ast.CallExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if !isPanicCall(n) {
+				return true
+			}
+			line := c.fset.Position(n.Pos()).Line
+			if !marked[line] && !marked[line-1] && !isUnreachablePanic(n) {
+				found = append(found, n)
+			}
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// isUnreachablePanic reports whether the panic message
+// marks the panic as an "impossible" assertion.
+func isUnreachablePanic(call *ast.CallExpr) bool {
+	marked := false
+	ast.Inspect(call, func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			s, err := strconv.Unquote(lit.Value)
+			marked = marked || err == nil && unreachableRegexp.MatchString(s)
+		}
+		return !marked
+	})
+	return marked
+}
+
+func isPanicCall(x ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	id, ok := call.Fun.(*ast.Ident)
+	return ok && id.Name == "panic"
+}
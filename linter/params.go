@@ -0,0 +1,121 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// paramRefRegexp matches the doc words that look like parameter
+// references: backquoted lowercase identifiers, camelCase words and
+// the words in "the X parameter" or "the X argument" phrases.
+var paramRefRegexp = regexp.MustCompile("`([a-z_]\\w*)`|\\b([a-z]+[A-Z]\\w*)\\b|\\b[Tt]he ([a-z_]\\w*) (?:param|parameter|arg|argument)\\b")
+
+// checkParamRefs reports the doc-comment references to the parameters
+// that don't exist anymore, usually left after a parameter rename.
+//
+// A reference is stale only if it's not an identifier that is used
+// by the function or declared by the package, so mentions of the
+// package helpers and struct fields are not reported. The camelCase
+// words are references only if they look like a misspelled or renamed
+// parameter, so the prose words like "macOS" are not reported either.
+// Code blocks are not checked.
+func (c *checker) checkParamRefs(doc *ast.CommentGroup) {
+	fn := c.current.fn
+	used := make(map[string]bool)
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			used[id.Name] = true
+		}
+		return true
+	})
+
+	reported := make(map[string]bool)
+	for _, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) || isIndented(strings.TrimPrefix(text, " ")) {
+			continue
+		}
+		for _, m := range paramRefRegexp.FindAllStringSubmatch(comment.Text, -1) {
+			name := m[1] + m[2] + m[3]
+			if used[name] || c.declared[name] || reported[name] || c.words.Has(name) {
+				continue
+			}
+			if m[3] != "" && paramRefStopwords[name] {
+				// "the first argument" is not a reference.
+				continue
+			}
+			if m[2] != "" && !nearParam(fn, name) {
+				continue
+			}
+			reported[name] = true
+			c.warnComment("param-ref", comment, "doc-comment mentions %s, but %s has no such parameter", name, fn.Name.Name)
+		}
+	}
+}
+
+// nearParam reports whether the name is likely a misspelled or
+// renamed parameter of the fn, like dstPath for destPath.
+func nearParam(fn *ast.FuncDecl, name string) bool {
+	for _, list := range []*ast.FieldList{fn.Type.TypeParams, fn.Type.Params, fn.Type.Results} {
+		if list == nil {
+			continue
+		}
+		for _, field := range list.List {
+			for _, param := range field.Names {
+				if isNameTypo(name, param.Name) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// paramRefStopwords are the words that precede "parameter"
+// or "argument" in the prose, like in "the first argument".
+var paramRefStopwords = map[string]bool{
+	"first": true, "second": true, "third": true, "last": true,
+	"other": true, "given": true, "single": true, "optional": true,
+	"extra": true, "type": true, "same": true, "only": true,
+	"next": true, "previous": true,
+}
+
+// declaredNames returns names of all package-level declarations,
+// methods, struct fields and interface methods of the files.
+func declaredNames(files []*ast.File) map[string]bool {
+	names := make(map[string]bool)
+	addFields := func(list *ast.FieldList) {
+		if list == nil {
+			return
+		}
+		for _, field := range list.List {
+			for _, name := range field.Names {
+				names[name.Name] = true
+			}
+		}
+	}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				names[n.Name.Name] = true
+			case *ast.TypeSpec:
+				names[n.Name.Name] = true
+			case *ast.ValueSpec:
+				for _, name := range n.Names {
+					names[name.Name] = true
+				}
+			case *ast.StructType:
+				addFields(n.Fields)
+			case *ast.InterfaceType:
+				addFields(n.Methods)
+			case *ast.BlockStmt:
+				// Local declarations are not visible to the other functions.
+				return false
+			}
+			return true
+		})
+	}
+	return names
+}
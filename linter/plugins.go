@@ -0,0 +1,153 @@
+package linter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"plugin"
+	"sync"
+)
+
+// Check is a third-party documentation check. The checks are
+// registered with Register, usually from the init function
+// of the package that implements them.
+//
+// The registered checks are configured and reported like the builtin
+// ones: their severities can be overridden with -severity, their
+// messages can be customized in the config, and the findings
+// are filtered by -diff and -base.
+type Check interface {
+	// Name is the check name used in the output, -severity overrides
+	// and the config. It must not clash with the other check names.
+	Name() string
+
+	// Description is a one-sentence summary of what the check enforces.
+	Description() string
+
+	// Targets tells which comments are passed to Run.
+	Targets() Target
+
+	// Run checks the comment group and returns the findings. The Check,
+	// ID and Severity fields of the returned diagnostics are ignored.
+	// The fixes are applied with -fix, only their offsets are used.
+	Run(ctx *Context, doc *ast.CommentGroup) []Diagnostic
+}
+
+// Target is a set of the comment kinds checked by a Check.
+type Target int
+
+const (
+	// TargetDecl are the doc-comments of the package-level declarations
+	// and the methods, the specs of the grouped declarations included.
+	TargetDecl Target = 1 << iota
+
+	// TargetPackage are the package doc-comments.
+	TargetPackage
+
+	// TargetComment are all comment groups of the file.
+	TargetComment
+)
+
+// Context describes the comment passed to Check.Run.
+type Context struct {
+	Fset *token.FileSet
+	File *ast.File
+
+	// Decl is the documented *ast.FuncDecl, *ast.GenDecl, *ast.TypeSpec
+	// or *ast.ValueSpec. It's nil unless the target is TargetDecl.
+	Decl ast.Node
+
+	// Name is the documented identifier and Recv is the method receiver
+	// type name. Name is empty for the grouped declaration docs.
+	Name string
+	Recv string
+}
+
+// Diagnostic returns the diagnostic about the node source range.
+func (ctx *Context) Diagnostic(node ast.Node, format string, args ...any) Diagnostic {
+	return Diagnostic{
+		Pos:     ctx.Fset.Position(node.Pos()),
+		End:     ctx.Fset.Position(node.End()),
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+var registry struct {
+	mu     sync.Mutex
+	checks []Check
+}
+
+// Register adds the check to all linters created after the call.
+// It panics if there is already a check with the same name.
+func Register(check Check) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	name := check.Name()
+	if findCheck(name) != nil || findRegistered(registry.checks, name) != nil {
+		panic(fmt.Sprintf("doccheck: check %q is already registered", name))
+	}
+	registry.checks = append(registry.checks, check)
+}
+
+// registeredChecks returns a snapshot of the registered checks.
+func registeredChecks() []Check {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	return append([]Check(nil), registry.checks...)
+}
+
+func findRegistered(checks []Check, name string) Check {
+	for _, check := range checks {
+		if check.Name() == name {
+			return check
+		}
+	}
+	return nil
+}
+
+// loadPlugins opens the Go plugins, which register
+// their checks from the init functions.
+func loadPlugins(paths []string) error {
+	for _, path := range paths {
+		if _, err := plugin.Open(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runChecks runs the registered checks of the given target.
+// The decl is the zero declDoc for the non-decl targets.
+func (c *checker) runChecks(target Target, f *ast.File, d declDoc, doc *ast.CommentGroup) {
+	for _, check := range c.checks {
+		if check.Targets()&target == 0 {
+			continue
+		}
+		ctx := &Context{Fset: c.fset, File: f, Decl: d.node, Name: d.name, Recv: d.recv}
+		for _, diag := range check.Run(ctx, doc) {
+			toLine := diag.Pos.Line
+			if diag.End.Line != 0 {
+				toLine = diag.End.Line
+			}
+			for _, fix := range diag.Fixes {
+				c.suggestFix(textEdit{
+					check:    check.Name(),
+					filename: fix.Pos.Filename,
+					start:    fix.Pos.Offset,
+					end:      fix.End.Offset,
+					newText:  fix.NewText,
+				})
+			}
+			c.warn(issue{
+				Check:    check.Name(),
+				Pos:      diag.Pos,
+				End:      diag.End,
+				FromLine: diag.Pos.Line,
+				ToLine:   toLine,
+				TestSeam: c.current.testSeam && ast.IsExported(d.name),
+				Name:     diag.Name,
+				Message:  diag.Message,
+			})
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestPredicateResults(t *testing.T) {
+	dir := t.TempDir()
+	src := `// Package p is a test.
+package p
+
+// V is a value.
+type V int
+
+// HasX returns the X flag.
+func HasX() bool { return true }
+
+// Lookup returns the value of k and whether it exists.
+func Lookup(k string) (V, bool) { return 0, false }
+
+// HasValue returns the value of k and whether it's set.
+func HasValue(k string) (V, bool) { return 0, false }
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, typeInfo := range []bool{false, true} {
+		l := &linter{
+			fset:                       token.NewFileSet(),
+			maxFirstParagraphSentences: 3,
+			logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+			typeInfo:                   typeInfo,
+		}
+		if err := l.applyConfig(defaultConfig(), nil); err != nil {
+			t.Fatal(err)
+		}
+		var reported []string
+		l.sink = func(iss issue, sev severity) {
+			if iss.Check == "predicate" {
+				reported = append(reported, iss.Name)
+			}
+		}
+		l.checkDir(dir)
+		if want := []string{"HasX"}; !slices.Equal(reported, want) {
+			t.Errorf("types %v: predicate is reported for %q, want %q", typeInfo, reported, want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package linter
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+)
+
+// The -format=rdjson report is the reviewdog diagnostic format.
+// The columns are 1-based and count the bytes, like in doccheck.
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonDiagnostic struct {
+	Message     string             `json:"message"`
+	Location    rdjsonLocation     `json:"location"`
+	Severity    string             `json:"severity"`
+	Code        *rdjsonCode        `json:"code,omitempty"`
+	Suggestions []rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string       `json:"path"`
+	Range *rdjsonRange `json:"range,omitempty"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+// rdjsonSeverities maps the severities to the reviewdog ones.
+var rdjsonSeverities = [...]string{
+	severityInfo:    "INFO",
+	severityWarning: "WARNING",
+	severityError:   "ERROR",
+}
+
+// writeRDJSONReport writes the collected issues in the reviewdog
+// rdjson format, with the suggested fixes as the suggestions.
+// The fixes without the line positions can't be expressed in it.
+func writeRDJSONReport(w io.Writer, c *issueCollector) error {
+	report := rdjsonResult{
+		Source:      rdjsonSource{Name: "doccheck", URL: "https://github.com/Quasilyte/doccheck"},
+		Diagnostics: make([]rdjsonDiagnostic, 0, len(c.diagnostics)),
+	}
+	for _, d := range c.diagnostics {
+		entry := rdjsonDiagnostic{
+			Message:  d.Message,
+			Location: rdjsonLocation{Path: d.Pos.Filename},
+			Severity: rdjsonSeverities[d.severity()],
+			Code:     &rdjsonCode{Value: cmp.Or(d.ID, d.Check)},
+		}
+		if d.Pos.Line != 0 {
+			entry.Location.Range = &rdjsonRange{Start: rdjsonPosition{Line: d.Pos.Line, Column: d.Pos.Column}}
+			if d.End.Line != 0 {
+				entry.Location.Range.End = &rdjsonPosition{Line: d.End.Line, Column: d.End.Column}
+			}
+		}
+		for _, fix := range d.Fixes {
+			if fix.Pos.Line == 0 {
+				continue
+			}
+			entry.Suggestions = append(entry.Suggestions, rdjsonSuggestion{
+				Range: rdjsonRange{
+					Start: rdjsonPosition{Line: fix.Pos.Line, Column: fix.Pos.Column},
+					End:   &rdjsonPosition{Line: fix.End.Line, Column: fix.End.Column},
+				},
+				Text: fix.NewText,
+			})
+		}
+		report.Diagnostics = append(report.Diagnostics, entry)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
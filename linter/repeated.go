@@ -0,0 +1,85 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// proseWordsRegexp matches the words of the prose. The identifiers
+// like ServeHTTP or io_test are matched as a whole, so they can be
+// told apart by isProseWord.
+var proseWordsRegexp = regexp.MustCompile(`[\p{L}\d_]+`)
+
+// repeatedWordExempt are the correct English repeats.
+var repeatedWordExempt = map[string]bool{
+	"had had":   true,
+	"that that": true,
+}
+
+// checkRepeatedWords reports the words repeated one after another,
+// ignoring the case, like "the the", including the repeats wrapped
+// across the lines. Code blocks, code spans, the double-quoted text,
+// the identifiers and the declared names are not checked.
+func (c *checker) checkRepeatedWords(doc *ast.CommentGroup) {
+	// prev is the last word of the paragraph, "" after the punctuation.
+	prev := ""
+	for _, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) || isIndented(strings.TrimPrefix(text, " ")) || strings.TrimSpace(text) == "" {
+			prev = ""
+			continue
+		}
+		masked := spanRegexp.ReplaceAllStringFunc(comment.Text, func(s string) string {
+			return strings.Repeat("#", len(s))
+		})
+		pos := c.fset.Position(comment.Pos())
+		prevEnd := 2 // The words on the previous lines are separated by the line break.
+		for _, loc := range proseWordsRegexp.FindAllStringIndex(masked, -1) {
+			word := masked[loc[0]:loc[1]]
+			if strings.TrimSpace(masked[prevEnd:loc[0]]) != "" {
+				prev = ""
+			}
+			repeated := prev != "" && strings.EqualFold(prev, word)
+			if repeated && !repeatedWordExempt[strings.ToLower(prev+" "+word)] && !c.declared[word] && !c.words.Has(word) {
+				if prevEnd != 2 {
+					c.suggestFix(textEdit{
+						check:    "repeated-word",
+						filename: pos.Filename,
+						start:    pos.Offset + prevEnd,
+						end:      pos.Offset + loc[1],
+					})
+				}
+				c.warnComment("repeated-word", comment, "%q is repeated, remove the duplicated word", strings.ToLower(word))
+			}
+			prev = ""
+			if isProseWord(word) {
+				prev = word
+			}
+			prevEnd = loc[1]
+		}
+		if strings.TrimSpace(masked[prevEnd:]) != "" {
+			prev = ""
+		}
+	}
+}
+
+// isProseWord reports whether the word is a lower case or a capitalized
+// word, rather than an identifier, a number or a one-letter variable.
+func isProseWord(word string) bool {
+	if word == "a" || word == "A" {
+		return true
+	}
+	if len(word) < 2 {
+		return false
+	}
+	for i, r := range word {
+		switch {
+		case r == '_' || r >= '0' && r <= '9':
+			return false
+		case i > 0 && strings.ToLower(string(r)) != string(r):
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,183 @@
+package linter
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"path/filepath"
+)
+
+// The -format=sarif report follows the SARIF 2.1.0 schema, only
+// the parts used by the code scanning tools are filled in.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+	Fixes     []sarifFix      `json:"fixes,omitempty"`
+
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+// sarifSuppression marks the result suppressed by a //nolint directive.
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifRegion is either a line and column range or a byte range.
+type sarifRegion struct {
+	StartLine   int  `json:"startLine,omitempty"`
+	StartColumn int  `json:"startColumn,omitempty"`
+	EndLine     int  `json:"endLine,omitempty"`
+	EndColumn   int  `json:"endColumn,omitempty"`
+	ByteOffset  *int `json:"byteOffset,omitempty"`
+	ByteLength  *int `json:"byteLength,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion  `json:"deletedRegion"`
+	InsertedContent sarifMessage `json:"insertedContent"`
+}
+
+// sarifLevels maps the severities to the SARIF result levels.
+var sarifLevels = [...]string{
+	severityInfo:    "note",
+	severityWarning: "warning",
+	severityError:   "error",
+}
+
+// writeSARIFReport writes the collected issues as a SARIF log.
+// The rules are the checks that have the issues, the results refer
+// to them by the check IDs, or by the names for the custom rules.
+//
+// The suggested fixes replace the byte ranges, so they don't depend
+// on how the consumer counts the columns.
+func (l *linter) writeSARIFReport(w io.Writer, c *issueCollector) error {
+	ruleIDs := make(map[string]string)
+	results := make([]sarifResult, 0, len(c.diagnostics))
+	for _, d := range c.diagnostics {
+		id := cmp.Or(d.ID, d.Check)
+		ruleIDs[d.Check] = id
+
+		uri := filepath.ToSlash(d.Pos.Filename)
+		location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: uri}}
+		if d.Pos.Line != 0 {
+			location.Region = &sarifRegion{
+				StartLine:   d.Pos.Line,
+				StartColumn: d.Pos.Column,
+				EndLine:     d.End.Line,
+				EndColumn:   d.End.Column,
+			}
+		}
+		result := sarifResult{
+			RuleID:    id,
+			Level:     sarifLevels[d.severity()],
+			Message:   sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{{PhysicalLocation: location}},
+		}
+		for _, fix := range d.Fixes {
+			offset, length := fix.Pos.Offset, fix.End.Offset-fix.Pos.Offset
+			result.Fixes = append(result.Fixes, sarifFix{
+				Description: sarifMessage{Text: "Fix the " + d.Check + " issue"},
+				ArtifactChanges: []sarifArtifactChange{{
+					ArtifactLocation: sarifArtifactLocation{URI: uri},
+					Replacements: []sarifReplacement{{
+						DeletedRegion:   sarifRegion{ByteOffset: &offset, ByteLength: &length},
+						InsertedContent: sarifMessage{Text: fix.NewText},
+					}},
+				}},
+			})
+		}
+		if s := d.Suppression; s != nil {
+			result.Suppressions = []sarifSuppression{{Kind: "inSource", Justification: s.Justification}}
+		}
+		results = append(results, result)
+	}
+
+	rules := []sarifRule{}
+	for _, name := range sortedKeys(ruleIDs) {
+		rules = append(rules, sarifRule{
+			ID:                   ruleIDs[name],
+			Name:                 name,
+			ShortDescription:     sarifMessage{Text: l.checkDescription(name)},
+			DefaultConfiguration: sarifConfiguration{Level: sarifLevels[l.severities[name]]},
+		})
+	}
+
+	report := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "doccheck",
+				InformationURI: "https://github.com/Quasilyte/doccheck",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
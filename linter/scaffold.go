@@ -0,0 +1,96 @@
+package linter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// scaffoldStub is the -scaffold doc template, the placeholder
+// is the documented name.
+const scaffoldStub = "// %s ... (TODO: document)"
+
+// scaffoldDocs adds the doc stubs of the undocumented exported
+// declarations of the package to the edits, the same way as the
+// -fix edits. Commands, tests and the files of other owners are
+// skipped, like for the -coverage.
+//
+// A grouped declaration doc documents all its specs, and the specs
+// with a line comment are documented by it.
+func (c *checker) scaffoldDocs(pkg *ast.Package) {
+	if pkg.Name == "main" || strings.HasSuffix(pkg.Name, "_test") {
+		return
+	}
+	for _, filename := range sortedKeys(pkg.Files) {
+		if strings.HasSuffix(filename, "_test.go") || !c.isOwned(filename) {
+			continue
+		}
+		for _, decl := range pkg.Files[filename].Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				d := funcDeclDoc(decl)
+				if decl.Doc == nil && ast.IsExported(d.name) && (d.recv == "" || ast.IsExported(d.recv)) {
+					c.scaffoldStub(decl.Pos(), d.name)
+				}
+			case *ast.GenDecl:
+				if decl.Doc != nil {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					name, pos := "", spec.Pos()
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Doc == nil && spec.Comment == nil {
+							name = spec.Name.Name
+						}
+					case *ast.ValueSpec:
+						if spec.Doc == nil && spec.Comment == nil {
+							name = firstExported(spec.Names)
+						}
+					}
+					if !decl.Lparen.IsValid() {
+						// The stub of a single spec goes above the keyword.
+						pos = decl.Pos()
+					}
+					if ast.IsExported(name) {
+						c.scaffoldStub(pos, name)
+					}
+				}
+			}
+		}
+	}
+}
+
+// scaffoldStub inserts the stub of the name on its own line
+// above the pos, with the same indentation.
+func (c *checker) scaffoldStub(pos token.Pos, name string) {
+	p := c.fset.Position(pos)
+	src := c.sources[p.Filename]
+	if src == nil {
+		return
+	}
+	lineStart := p.Offset - (p.Column - 1)
+	indent := string(src[lineStart:p.Offset])
+	if strings.TrimLeft(indent, " \t") != "" {
+		// The declaration doesn't start its line.
+		return
+	}
+	c.addFix(textEdit{
+		check:    "scaffold",
+		filename: p.Filename,
+		start:    lineStart,
+		end:      lineStart,
+		newText:  indent + fmt.Sprintf(scaffoldStub, name) + "\n",
+	})
+}
+
+// firstExported returns the first exported name, or "" if there is none.
+func firstExported(names []*ast.Ident) string {
+	for _, name := range names {
+		if name.IsExported() {
+			return name.Name
+		}
+	}
+	return ""
+}
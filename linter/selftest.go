@@ -0,0 +1,88 @@
+package linter
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+)
+
+// selftestCorpus has the known-bad and known-good fixtures of the
+// builtin checks: the bad and good packages of the check directories,
+// see checkCorpus.
+//
+//go:embed testdata/selftest
+var selftestCorpus embed.FS
+
+// selftest checks the bundled corpus and the fixture dirs with the
+// options and writes the mismatches. It reports whether there are none.
+//
+// With the project config and plugins, the corpus shows the false
+// positives of the custom rules and plugin checks on the good files,
+// and the checks that the config turns off for the bad ones. The samples
+// of the optional checks are skipped unless the config enables them.
+func selftest(w io.Writer, dirs []string, opts Options) (bool, error) {
+	corpus, err := fs.Sub(selftestCorpus, "testdata/selftest")
+	if err != nil {
+		return false, err
+	}
+	mismatches, err := checkCorpus(corpus, opts)
+	if err != nil {
+		return false, fmt.Errorf("corpus: %v", err)
+	}
+	for _, dir := range dirs {
+		m, err := CheckFixtures(dir, opts)
+		if err != nil {
+			return false, err
+		}
+		mismatches = append(mismatches, m...)
+	}
+
+	for _, m := range mismatches {
+		fmt.Fprintln(w, m)
+	}
+	if len(mismatches) != 0 {
+		fmt.Fprintf(w, "selftest: %d mismatches with the expected diagnostics\n", len(mismatches))
+		return false, nil
+	}
+	fmt.Fprintf(w, "selftest: no mismatches in the corpus and %d fixture directories\n", len(dirs))
+	return true, nil
+}
+
+// checkCorpus checks the bad and good samples of every builtin check
+// in the corpus with the options, see CheckFixtures. The checks without
+// one of the samples are reported as the mismatches too.
+func checkCorpus(corpus fs.FS, opts Options) ([]string, error) {
+	conf := opts.Config
+	if conf == nil {
+		conf = defaultConfig()
+	}
+	s, err := newSettings(conf, opts.Severities)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []string
+	for _, info := range checkList {
+		for _, sample := range []string{"bad", "good"} {
+			if _, err := fs.Stat(corpus, path.Join(info.name, sample)); err != nil {
+				mismatches = append(mismatches, fmt.Sprintf("selftest/%s: no %s sample of the %s check", info.name, sample, checkLabel(info.name)))
+			}
+		}
+		if _, err := fs.Stat(corpus, info.name); err != nil || !s.checkEnabled(info.name) {
+			continue
+		}
+		samples, err := fs.Sub(corpus, info.name)
+		if err != nil {
+			return nil, err
+		}
+		m, err := checkFixtures(samples, filepath.Join("selftest", info.name), opts, false)
+		if err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, m...)
+	}
+	return mismatches, nil
+}
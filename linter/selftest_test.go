@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSelftest(t *testing.T) {
+	var out strings.Builder
+	ok, err := selftest(&out, nil, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Errorf("selftest fails with the default config:\n%s", out.String())
+	}
+}
+
+func TestSelftestInModule(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":  "module example.com/user\n",
+		wordsFile: "the\n",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Chdir(dir)
+
+	var out strings.Builder
+	ok, err := selftest(&out, nil, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Errorf("selftest fails in a module with a words file:\n%s", out.String())
+	}
+}
+
+func TestCorpusMissingSamples(t *testing.T) {
+	corpus := fstest.MapFS{
+		"predicate/bad/bad.go": {Data: []byte("// Package bad is bad.\npackage bad\n\n// IsOK tells whether it's ok. // want \"bad predicate comment\"\nfunc IsOK() bool { return true }\n")},
+	}
+	mismatches, err := checkCorpus(corpus, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := strings.Join(mismatches, "\n")
+	if strings.Contains(text, "selftest/predicate: no bad sample") || strings.Contains(text, "selftest/predicate/bad") {
+		t.Errorf("the predicate bad sample is reported:\n%s", text)
+	}
+	for _, want := range []string{
+		"selftest/predicate: no good sample of the DC008 predicate check",
+		"selftest/parse: no bad sample of the DC001 parse check",
+		"selftest/sentence-case: no good sample of the DC052 sentence-case check",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("no %q mismatch in:\n%s", want, text)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package linter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// sentence is a single sentence of a tokenized text.
+type sentence struct {
+	// Text is text[Start:End] of the tokenized text.
+	Text string
+
+	Start int
+	End   int
+}
+
+// sentenceTokenizer splits prose into sentences.
+//
+// All checks that need to reason about sentences should
+// use the linter tokenizer instead of ad-hoc splitting,
+// so they agree on where the sentence ends.
+type sentenceTokenizer interface {
+	Sentences(text string) []sentence
+}
+
+// defaultAbbreviations never end a sentence unless they're
+// at the end of the text.
+var defaultAbbreviations = []string{
+	"a.k.a.",
+	"approx.",
+	"cf.",
+	"e.g.",
+	"esp.",
+	"etc.",
+	"i.e.",
+	"incl.",
+	"resp.",
+	"viz.",
+	"vs.",
+}
+
+// proseTokenizer is a sentenceTokenizer for English prose.
+//
+// The sentence ends with '.', '!' or '?' followed by a whitespace,
+// with the exception of abbreviations, ellipses and punctuation
+// inside quotes. "1.5" or "pkg.Func" are not split as the
+// period is not followed by a whitespace.
+type proseTokenizer struct {
+	abbreviations map[string]bool
+}
+
+func newProseTokenizer(extraAbbreviations []string) *proseTokenizer {
+	t := &proseTokenizer{abbreviations: make(map[string]bool)}
+	for _, list := range [][]string{defaultAbbreviations, extraAbbreviations} {
+		for _, abbr := range list {
+			t.abbreviations[strings.ToLower(abbr)] = true
+		}
+	}
+	return t
+}
+
+func (t *proseTokenizer) Sentences(text string) []sentence {
+	var sentences []sentence
+	start := skipSpace(text, 0)
+	inQuote := false
+	for i := start; i < len(text); i++ {
+		ch := text[i]
+		switch ch {
+		case '"', '`':
+			inQuote = !inQuote
+			continue
+		case '.', '!', '?':
+			// Possible sentence end.
+		default:
+			continue
+		}
+		if inQuote {
+			continue
+		}
+		if strings.HasPrefix(text[i:], "...") {
+			for i+1 < len(text) && text[i+1] == '.' {
+				i++
+			}
+			continue
+		}
+
+		end := i + 1
+		for end < len(text) && strings.IndexByte(")]'", text[end]) != -1 {
+			end++
+		}
+		if end < len(text) && !isSpaceByte(text[end]) {
+			continue
+		}
+		if ch == '.' && end < len(text) && t.isAbbreviation(text[:i+1]) {
+			continue
+		}
+
+		sentences = append(sentences, sentence{Text: text[start:end], Start: start, End: end})
+		start = skipSpace(text, end)
+		i = start - 1
+	}
+
+	if rest := strings.TrimRightFunc(text[start:], unicode.IsSpace); rest != "" {
+		sentences = append(sentences, sentence{Text: rest, Start: start, End: start + len(rest)})
+	}
+	return sentences
+}
+
+// isAbbreviation reports whether the last word of s is a known abbreviation.
+func (t *proseTokenizer) isAbbreviation(s string) bool {
+	word := s[strings.LastIndexAny(s, " \t\n(")+1:]
+	return t.abbreviations[strings.ToLower(word)]
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) && isSpaceByte(s[i]) {
+		i++
+	}
+	return i
+}
+
+func isSpaceByte(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+// normalizeSpace replaces all whitespace sequences with a single space.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,175 @@
+package linter
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// spellingPairs are the American and British spelling variants.
+var spellingPairs = [][2]string{
+	{"color", "colour"},
+	{"colors", "colours"},
+	{"behavior", "behaviour"},
+	{"behaviors", "behaviours"},
+	{"favor", "favour"},
+	{"favorite", "favourite"},
+	{"honor", "honour"},
+	{"labor", "labour"},
+	{"center", "centre"},
+	{"centers", "centres"},
+	{"gray", "grey"},
+	{"catalog", "catalogue"},
+	{"artifact", "artefact"},
+	{"artifacts", "artefacts"},
+	{"fulfill", "fulfil"},
+	{"judgment", "judgement"},
+	{"acknowledgment", "acknowledgement"},
+	{"analyze", "analyse"},
+	{"analyzed", "analysed"},
+	{"analyzing", "analysing"},
+	{"analyzer", "analyser"},
+}
+
+// doubledConsonantStems are the verbs that double the final
+// consonant in the British English, like "cancelled".
+var doubledConsonantStems = []string{
+	"cancel", "label", "model", "travel", "signal", "marshal", "unmarshal",
+	"channel", "level", "tunnel", "fuel", "total", "dial",
+}
+
+// izeStems are the verbs spelled with -ise in the British English.
+var izeStems = []string{
+	"initializ", "serializ", "deserializ", "normaliz", "optimiz", "organiz",
+	"recogniz", "authoriz", "customiz", "finaliz", "minimiz", "maximiz",
+	"synchroniz", "sanitiz", "summariz", "utiliz", "materializ", "standardiz",
+	"categoriz", "prioritiz", "specializ", "visualiz", "tokeniz", "capitaliz",
+	"canonicaliz", "memoiz", "parametriz", "parameteriz", "localiz", "internationaliz",
+}
+
+// spellingVariants maps the spelling variants to their counterparts,
+// isBritish tells which variant is the British one.
+var spellingVariants, isBritish = buildSpellingVariants()
+
+func buildSpellingVariants() (map[string]string, map[string]bool) {
+	pairs := append([][2]string(nil), spellingPairs...)
+	for _, stem := range doubledConsonantStems {
+		last := stem[len(stem)-1:]
+		for _, suffix := range []string{"ed", "ing", "er", "ers"} {
+			pairs = append(pairs, [2]string{stem + suffix, stem + last + suffix})
+		}
+	}
+	for _, stem := range izeStems {
+		british := strings.TrimSuffix(stem, "z") + "s"
+		for _, suffix := range []string{"e", "ed", "es", "ing", "er", "ers", "ation", "ations"} {
+			pairs = append(pairs, [2]string{stem + suffix, british + suffix})
+		}
+	}
+
+	variants := make(map[string]string, 2*len(pairs))
+	british := make(map[string]bool, len(pairs))
+	for _, p := range pairs {
+		variants[p[0]] = p[1]
+		variants[p[1]] = p[0]
+		british[p[1]] = true
+	}
+	return variants, british
+}
+
+// spellingRef is a spelling variant found in a doc-comment.
+type spellingRef struct {
+	comment *ast.Comment
+	offset  int
+	word    string
+	british bool
+}
+
+// checkSpelling checks that the package docs use the same spelling
+// variants, either the configured ones or the ones used the most
+// in the "consistent" mode. The declared identifiers and
+// the double-quoted words are not checked.
+func (c *checker) checkSpelling(pkg *ast.Package) {
+	if c.spelling == "" {
+		return
+	}
+
+	var docs []*ast.CommentGroup
+	for _, f := range sortedFiles(pkg) {
+		if f.Doc != nil {
+			docs = append(docs, f.Doc)
+		}
+	}
+	for _, d := range collectDeclDocs(pkg) {
+		docs = append(docs, d.doc)
+	}
+
+	var refs []spellingRef
+	british := 0
+	for _, doc := range docs {
+		for _, comment := range doc.List {
+			text, ok := strings.CutPrefix(comment.Text, "//")
+			if !ok || isDirective(comment.Text) || isIndented(strings.TrimPrefix(text, " ")) {
+				continue
+			}
+			blank := func(s string) string { return strings.Repeat(" ", len(s)) }
+			text = urlRegexp.ReplaceAllStringFunc(text, blank)
+			text = doubleQuotedRegexp.ReplaceAllStringFunc(text, blank)
+			for _, loc := range proseWordRegexp.FindAllStringIndex(text, -1) {
+				word := text[loc[0]:loc[1]]
+				lower := strings.ToLower(word)
+				if _, ok := spellingVariants[lower]; !ok || c.declared[word] || c.words.Has(word) {
+					continue
+				}
+				ref := spellingRef{comment: comment, offset: len("//") + loc[0], word: word, british: isBritish[lower]}
+				if ref.british {
+					british++
+				}
+				refs = append(refs, ref)
+			}
+		}
+	}
+
+	wantBritish := false
+	switch c.spelling {
+	case "british":
+		wantBritish = true
+	case "consistent":
+		if british == 0 || british == len(refs) {
+			return
+		}
+		wantBritish = british > len(refs)-british
+	}
+	want := "American"
+	if wantBritish {
+		want = "British"
+	}
+
+	for _, ref := range refs {
+		if ref.british == wantBritish {
+			continue
+		}
+		variant := matchCase(spellingVariants[strings.ToLower(ref.word)], ref.word)
+		pos := c.fset.Position(ref.comment.Pos())
+		c.suggestFix(textEdit{
+			check:    "spelling",
+			filename: pos.Filename,
+			start:    pos.Offset + ref.offset,
+			end:      pos.Offset + ref.offset + len(ref.word),
+			newText:  variant,
+		})
+		c.warnComment("spelling", ref.comment, "%s should be spelled %s, the package docs use %s English", ref.word, variant, want)
+	}
+}
+
+// matchCase returns the lower case word capitalized like the sample.
+func matchCase(word, sample string) string {
+	switch {
+	case sample == strings.ToUpper(sample):
+		return strings.ToUpper(word)
+	case unicode.IsUpper([]rune(sample)[0]):
+		r, size := utf8.DecodeRuneInString(word)
+		return string(unicode.ToUpper(r)) + word[size:]
+	}
+	return word
+}
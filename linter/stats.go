@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// runStats is a summary of the linter run, collected for -stats.
+type runStats struct {
+	filesScanned int
+	issues       int
+	byCheck      map[string]int
+	byFile       map[string]int
+}
+
+func newRunStats() *runStats {
+	return &runStats{
+		byCheck: make(map[string]int),
+		byFile:  make(map[string]int),
+	}
+}
+
+// addIssue records a reported issue. Must be called with linter mu held.
+func (s *runStats) addIssue(iss issue) {
+	s.issues++
+	s.byCheck[iss.Check]++
+	s.byFile[iss.Pos.Filename]++
+}
+
+func (s *runStats) Print(w io.Writer) {
+	total := s.issues
+	fmt.Fprintf(w, "files scanned: %d\n", s.filesScanned)
+	fmt.Fprintf(w, "issues total: %d\n", total)
+	if total == 0 {
+		return
+	}
+	fmt.Fprintf(w, "issues per check:\n")
+	printCounts(w, s.byCheck)
+	fmt.Fprintf(w, "issues per file:\n")
+	printCounts(w, s.byFile)
+}
+
+// printCounts prints counts in descending order.
+func printCounts(w io.Writer, counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %6d %s\n", counts[k], k)
+	}
+}
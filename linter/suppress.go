@@ -0,0 +1,116 @@
+package linter
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// Suppression is the //nolint directive that suppresses a finding.
+type Suppression struct {
+	// Directive is the directive text, like "//nolint:doccheck".
+	Directive string
+
+	// Justification is the explanation after the directive,
+	// like "generated names" in "//nolint:doccheck // generated names".
+	Justification string
+}
+
+// nolintRange is the source lines range of a declaration
+// with a //nolint directive in its doc-comment.
+type nolintRange struct {
+	from, to int
+
+	// names are the directive linter and check names,
+	// empty if the directive suppresses everything.
+	names []string
+
+	suppression *Suppression
+}
+
+// collectNolint returns the //nolint directives of the doc-comments
+// by the file names. A directive suppresses the findings of the whole
+// documented declaration, its doc-comment included, the same way as
+// in golangci-lint.
+func collectNolint(fset *token.FileSet, files []*ast.File) map[string][]nolintRange {
+	ranges := make(map[string][]nolintRange)
+	for _, f := range files {
+		filename := fset.Position(f.Package).Filename
+		add := func(doc *ast.CommentGroup, end token.Pos) {
+			if doc == nil {
+				return
+			}
+			for _, comment := range doc.List {
+				names, s, ok := parseNolint(comment.Text)
+				if !ok {
+					continue
+				}
+				ranges[filename] = append(ranges[filename], nolintRange{
+					from:        fset.Position(doc.Pos()).Line,
+					to:          fset.Position(end).Line,
+					names:       names,
+					suppression: s,
+				})
+			}
+		}
+		add(f.Doc, f.Name.End())
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				add(n.Doc, n.End())
+			case *ast.GenDecl:
+				add(n.Doc, n.End())
+			case *ast.TypeSpec:
+				add(n.Doc, n.End())
+			case *ast.ValueSpec:
+				add(n.Doc, n.End())
+			case *ast.Field:
+				add(n.Doc, n.End())
+			}
+			return true
+		})
+	}
+	return ranges
+}
+
+// parseNolint parses the //nolint or //nolint:a,b directive
+// with an optional "// justification".
+func parseNolint(text string) (names []string, s *Suppression, ok bool) {
+	body, ok := strings.CutPrefix(text, "//nolint")
+	if !ok || body != "" && body[0] != ':' && body[0] != ' ' {
+		return nil, nil, false
+	}
+	body, justification, _ := strings.Cut(body, "//")
+	body = strings.TrimSpace(body)
+	if list, ok := strings.CutPrefix(body, ":"); ok {
+		for _, name := range strings.Split(list, ",") {
+			names = append(names, strings.TrimSpace(name))
+		}
+	} else if body != "" {
+		return nil, nil, false
+	}
+	s = &Suppression{
+		Directive:     "//nolint" + body,
+		Justification: strings.TrimSpace(justification),
+	}
+	return names, s, true
+}
+
+// suppression returns the //nolint directive that suppresses
+// the issue, nil if there is none. The directives suppress all
+// checks, or the listed ones, "doccheck" lists them all.
+//
+// The check IDs are not accepted, go/doc only hides the directives
+// that continue with a lower case letter, like //nolint:predicate.
+func (c *checker) suppression(iss issue) *Suppression {
+	for _, r := range c.nolint[iss.Pos.Filename] {
+		if iss.Pos.Line < r.from || iss.Pos.Line > r.to {
+			continue
+		}
+		if len(r.names) == 0 || slices.Contains(r.names, "doccheck") || slices.Contains(r.names, iss.Check) {
+			return r.suppression
+		}
+	}
+	return nil
+}
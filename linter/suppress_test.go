@@ -0,0 +1,135 @@
+package linter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const nolintSrc = `// Package p is a test.
+package p
+
+// IsA returns true if it's A.
+//
+//nolint:doccheck // The wording is kept.
+func IsA() bool { return true }
+
+// IsB returns true if it's B.
+//
+//nolint:predicate
+func IsB() bool { return true }
+
+// IsC returns true if it's C.
+//
+//nolint:errcheck
+func IsC() bool { return true }
+`
+
+func TestLintSuppression(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "p.go", nolintSrc, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diagnostics, err := Lint(fset, []*ast.File{f}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*Suppression{
+		"IsA": {Directive: "//nolint:doccheck", Justification: "The wording is kept."},
+		"IsB": {Directive: "//nolint:predicate"},
+		"IsC": nil,
+	}
+	for _, d := range diagnostics {
+		if d.Check != "predicate" {
+			continue
+		}
+		s, ok := want[d.Name]
+		if !ok {
+			t.Errorf("unexpected predicate diagnostic for %s", d.Name)
+			continue
+		}
+		delete(want, d.Name)
+		switch {
+		case s == nil && d.Suppression != nil:
+			t.Errorf("%s is suppressed by %+v", d.Name, *d.Suppression)
+		case s != nil && (d.Suppression == nil || *d.Suppression != *s):
+			t.Errorf("%s suppression:\nhave %+v\nwant %+v", d.Name, d.Suppression, *s)
+		}
+	}
+	for name := range want {
+		t.Errorf("no predicate diagnostic for %s", name)
+	}
+}
+
+func TestSuppressedNotReported(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(nolintSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	var reported []string
+	l.sink = func(iss issue, sev severity) {
+		if iss.Check == "predicate" {
+			reported = append(reported, iss.Name)
+		}
+	}
+	l.checkDir(dir)
+	if len(reported) != 1 || reported[0] != "IsC" {
+		t.Errorf("predicate is reported for %q, want only IsC", reported)
+	}
+	if l.failures != 1 {
+		t.Errorf("%d failures, want 1", l.failures)
+	}
+}
+
+func TestSuppressedNotFixed(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "p.go")
+	if err := os.WriteFile(filename, []byte(nolintSrc), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		fix:                        true,
+		sink:                       func(iss issue, sev severity) {},
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+	l.checkDir(dir)
+	applied, err := l.ApplyFixes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range applied {
+		if e.Start.Line < 14 {
+			t.Errorf("suppressed %s finding is fixed at line %d: %q -> %q", e.Check, e.Start.Line, e.Before, e.After)
+		}
+	}
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "// IsA returns true if it's A.") {
+		t.Errorf("the IsA doc kept by //nolint is rewritten:\n%s", src)
+	}
+	if !strings.Contains(string(src), "// IsC reports whether it's C.") {
+		t.Errorf("the IsC doc is not fixed:\n%s", src)
+	}
+}
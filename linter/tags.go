@@ -0,0 +1,64 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+// docTagRegexp matches the Javadoc, JSDoc and Doxygen tags like
+// "@param" or "\brief", including the inline link tags. Tags glued to the
+// preceding word, like in the email addresses, are not matched.
+var docTagRegexp = regexp.MustCompile(`(?:^|[\s{(])([@\\])(param|tparam|returns?|retval|throws|exception|raises?|brief|short|details|see|link|author|since|version|deprecated|note|warning|example|code)\b`)
+
+// docTagAdvice maps the tags to the idiomatic Go alternatives.
+var docTagAdvice = map[string]string{
+	"param":      "describe the parameters in the prose, referring to them by name",
+	"tparam":     "describe the type parameters in the prose, referring to them by name",
+	"return":     `describe the results in the prose, e.g. "Foo returns ..."`,
+	"returns":    `describe the results in the prose, e.g. "Foo returns ..."`,
+	"retval":     `describe the results in the prose, e.g. "Foo returns ..."`,
+	"throws":     "describe the returned errors or panics in the prose",
+	"exception":  "describe the returned errors or panics in the prose",
+	"raise":      "describe the returned errors or panics in the prose",
+	"raises":     "describe the returned errors or panics in the prose",
+	"brief":      "the first sentence is the summary, drop the tag",
+	"short":      "the first sentence is the summary, drop the tag",
+	"details":    "write the details as plain paragraphs",
+	"see":        "use a [Name] doc link",
+	"link":       "use a [Name] doc link",
+	"author":     "drop the tag, the authorship is tracked by the version control",
+	"since":      "drop the tag or mention the version in the prose",
+	"version":    "drop the tag or mention the version in the prose",
+	"deprecated": `use a "Deprecated: " paragraph`,
+	"note":       "write the note as a plain paragraph",
+	"warning":    "write the warning as a plain paragraph",
+	"example":    "add an Example test function or indent the example code",
+	"code":       "indent the code to render it as a code block",
+}
+
+// checkDocTags reports the documentation tags of the other languages.
+// go doc renders them verbatim, so they only clutter the text.
+// Code blocks are not checked, they may contain such tags legitimately.
+func (c *checker) checkDocTags(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) {
+			continue
+		}
+		text = strings.TrimPrefix(text, " ")
+		if isIndented(text) {
+			continue
+		}
+		m := docTagRegexp.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		if m[1] == `\` && !strings.HasPrefix(strings.TrimSpace(text), m[1]+m[2]) {
+			// Backslashes are common in the paths and regexps,
+			// so the Doxygen tags are only matched at the line start.
+			continue
+		}
+		c.warnComment("doc-tag", comment, "%s%s tag is not supported by go doc, %s", m[1], m[2], docTagAdvice[m[2]])
+	}
+}
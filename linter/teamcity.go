@@ -0,0 +1,67 @@
+package linter
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// teamcityPrinter writes the issues as the TeamCity service messages,
+// which the build shows in the Inspections tab. Every check is
+// described by an inspectionType message before its first issue:
+//
+//	##teamcity[inspectionType id='DC001' name='check' description='...' category='doccheck']
+//	##teamcity[inspection typeId='DC001' message='...' file='file.go' line='10' SEVERITY='WARNING']
+type teamcityPrinter struct {
+	w io.Writer
+	l *linter
+
+	// described are the checks with the printed inspection types.
+	described map[string]bool
+}
+
+// teamcitySeverities maps the severities to the inspection ones.
+var teamcitySeverities = [...]string{
+	severityInfo:    "INFO",
+	severityWarning: "WARNING",
+	severityError:   "ERROR",
+}
+
+// Print writes the issue inspection message.
+func (p *teamcityPrinter) Print(d Diagnostic) {
+	id := cmp.Or(d.ID, d.Check)
+	if !p.described[id] {
+		if p.described == nil {
+			p.described = make(map[string]bool)
+		}
+		p.described[id] = true
+		fmt.Fprintf(p.w, "##teamcity[inspectionType id='%s' name='%s' description='%s' category='doccheck']\n",
+			teamcityEscape(id), teamcityEscape(d.Check), teamcityEscape(p.l.checkDescription(d.Check)))
+	}
+	fmt.Fprintf(p.w, "##teamcity[inspection typeId='%s' message='%s' file='%s' line='%d' SEVERITY='%s']\n",
+		teamcityEscape(id), teamcityEscape(d.Message), teamcityEscape(d.Pos.Filename), max(d.Pos.Line, 1), teamcitySeverities[d.severity()])
+}
+
+// teamcityEscape escapes the service message attribute value.
+func teamcityEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '|', '\'', '[', ']':
+			b.WriteRune('|')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString("|n")
+		case '\r':
+			b.WriteString("|r")
+		default:
+			if r > 0x7f {
+				fmt.Fprintf(&b, "|0x%04x", r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
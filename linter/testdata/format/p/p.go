@@ -0,0 +1,10 @@
+package p
+
+// IsOK tells whether it's ok.
+func IsOK() bool { return true }
+
+// Parse parses the config from the data
+func Parse(data []byte) {}
+
+// Greet returns the the [greeting]: привет привет.
+func Greet() string { return "" }
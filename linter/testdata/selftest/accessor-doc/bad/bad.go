@@ -0,0 +1,8 @@
+// Package accessordoc is the accessor-doc check fixture.
+package accessordoc
+
+// User is a user.
+type User struct{ name string }
+
+// Name reports whether the name. // want "should be in the \"Name returns ...\" form"
+func (u *User) Name() string { return u.name }
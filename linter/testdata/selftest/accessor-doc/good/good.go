@@ -0,0 +1,11 @@
+// Package accessordoc is the known-good accessor-doc check fixture.
+package accessordoc
+
+// User is a user.
+type User struct{ name string }
+
+// Name returns the user name.
+func (u *User) Name() string { return u.name }
+
+// SetName sets the user name.
+func (u *User) SetName(name string) { u.name = name }
@@ -0,0 +1,7 @@
+// Package bugnote is the bug-note check fixture.
+package bugnote
+
+// BUG: doesn't handle the empty input. // want "should be in the \"BUG\\(owner\\): text\" form"
+
+// Parse parses the config.
+func Parse() {}
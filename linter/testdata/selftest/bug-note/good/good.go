@@ -0,0 +1,7 @@
+// Package bugnote is the known-good bug-note check fixture.
+package bugnote
+
+// BUG(alice): doesn't handle the empty input.
+
+// Parse parses the config.
+func Parse() {}
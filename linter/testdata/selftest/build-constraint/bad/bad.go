@@ -0,0 +1,3 @@
+//go:build linux // want "follow it with an empty line"
+// Package buildconstraint is the build-constraint check fixture.
+package buildconstraint
@@ -0,0 +1,4 @@
+//go:build linux
+
+// Package buildconstraint is the known-good build-constraint check fixture.
+package buildconstraint
@@ -0,0 +1,12 @@
+// Package codeblock is the code-block check fixture.
+package codeblock
+
+// Parse parses the config.
+//
+// Example:
+// ``` // want "fenced code blocks are not supported"
+// x := Parse()
+// ```
+//
+// It returns nothing.
+func Parse() {}
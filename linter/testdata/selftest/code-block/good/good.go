@@ -0,0 +1,9 @@
+// Package codeblock is the known-good code-block check fixture.
+package codeblock
+
+// Parse parses the config.
+//
+// Example:
+//
+//	x := Parse()
+func Parse() int { return 0 }
@@ -0,0 +1,8 @@
+// Package main is the command-doc check fixture. // want "should start with \"Bad\" or \"Command\""
+//
+// Usage:
+//
+//	command-doc
+package main
+
+func main() {}
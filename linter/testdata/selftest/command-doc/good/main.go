@@ -0,0 +1,8 @@
+// Good prints nothing, it's the known-good command-doc check fixture.
+//
+// Usage:
+//
+//	good
+package main
+
+func main() {}
@@ -0,0 +1,4 @@
+// Command prints nothing, it is the fixture of the command docs without the run instructions. // want "should describe the usage"
+package main
+
+func main() {}
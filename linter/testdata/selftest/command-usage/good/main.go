@@ -0,0 +1,8 @@
+// Command good prints nothing, it's the known-good command-usage check fixture.
+//
+// Usage:
+//
+//	good [flags]
+package main
+
+func main() {}
@@ -0,0 +1,11 @@
+// Package constructordoc is the constructor-doc check fixture.
+package constructordoc
+
+// Reader is a reader.
+type Reader struct{}
+
+// NewReader is a reader constructor. // want "\"NewReader returns a new Reader\" form"
+func NewReader(b []byte) *Reader { return nil }
+
+// NewReaderAt returns a new reader of the ReaderAt bytes. // want "should mention the constructed Reader type"
+func NewReaderAt(b []byte) *Reader { return nil }
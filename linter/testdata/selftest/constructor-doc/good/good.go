@@ -0,0 +1,14 @@
+// Package constructordoc is the known-good constructor-doc check fixture.
+package constructordoc
+
+// Reader is a reader.
+type Reader struct{}
+
+// NewReader returns a new Reader reading from b.
+func NewReader(b []byte) *Reader { return nil }
+
+// NewBuffer creates a Buffer with the initial contents.
+func NewBuffer(b []byte) *Buffer { return nil }
+
+// Buffer is a buffer.
+type Buffer struct{}
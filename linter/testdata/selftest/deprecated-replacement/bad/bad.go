@@ -0,0 +1,7 @@
+// Package deprecatedreplacement is the deprecated-replacement check fixture.
+package deprecatedreplacement
+
+// Parse parses the config.
+//
+// Deprecated: do not use. // want "should name a replacement"
+func Parse() {}
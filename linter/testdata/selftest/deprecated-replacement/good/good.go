@@ -0,0 +1,10 @@
+// Package deprecatedreplacement is the known-good deprecated-replacement check fixture.
+package deprecatedreplacement
+
+// Parse parses the config.
+//
+// Deprecated: Use [ParseFile] instead.
+func Parse() {}
+
+// ParseFile parses the config file.
+func ParseFile() {}
@@ -0,0 +1,9 @@
+// Package deprecated is the deprecated check fixture.
+package deprecated
+
+// Parse parses the config.
+// DEPRECATED: use ParseFile. // want "should start with \"Deprecated: \""
+func Parse() {}
+
+// ParseFile parses the config file.
+func ParseFile() {}
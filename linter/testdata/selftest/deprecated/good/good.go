@@ -0,0 +1,10 @@
+// Package deprecated is the known-good deprecated check fixture.
+package deprecated
+
+// Parse parses the config.
+//
+// Deprecated: Use ParseFile instead.
+func Parse() {}
+
+// ParseFile parses the config file.
+func ParseFile() {}
@@ -0,0 +1,7 @@
+// Package directiveformat is the directive-format check fixture.
+package directiveformat
+
+// go:generate stringer -type=Color // want "is not a directive because of the space"
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,7 @@
+// Package directiveformat is the known-good directive-format check fixture.
+package directiveformat
+
+//go:generate stringer -type=Color
+
+// Parse parses the config.
+func Parse() {}
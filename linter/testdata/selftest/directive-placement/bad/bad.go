@@ -0,0 +1,6 @@
+// Package directiveplacement is the directive-placement check fixture.
+package directiveplacement
+
+//go:noinline // want "should go after the doc-comment text"
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,7 @@
+// Package directiveplacement is the known-good directive-placement check fixture.
+package directiveplacement
+
+// Parse parses the config.
+//
+//go:noinline
+func Parse() {}
@@ -0,0 +1,7 @@
+// Package directivetypo is the directive-typo check fixture.
+package directivetypo
+
+//go:generates stringer -type=Color // want "looks like a misspelled directive"
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,7 @@
+// Package directivetypo is the known-good directive-typo check fixture.
+package directivetypo
+
+//go:generate stringer -type=Color
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,122 @@
+// Package docgo is the doc-go check fixture.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+//
+// The package doc is too long for a file other than doc.go.
+package docgo // want "should go into doc.go file"
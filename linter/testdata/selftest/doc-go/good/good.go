@@ -0,0 +1,4 @@
+// Package docgo is the known-good doc-go check fixture.
+//
+// Its doc is short, so it can stay outside of doc.go.
+package docgo
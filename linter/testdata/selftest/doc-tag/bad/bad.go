@@ -0,0 +1,6 @@
+// Package doctag is the doc-tag check fixture.
+package doctag
+
+// Parse parses the config.
+// @param path is the config file path. // want "@param tag is not supported"
+func Parse(path string) {}
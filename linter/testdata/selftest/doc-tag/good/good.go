@@ -0,0 +1,5 @@
+// Package doctag is the known-good doc-tag check fixture.
+package doctag
+
+// Parse parses the config from the path file.
+func Parse(path string) {}
@@ -0,0 +1,5 @@
+// Package doublespace is the double-space check fixture.
+package doublespace
+
+// Parse parses  the config. // want "double spaces between the words"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package doublespace is the known-good double-space check fixture.
+package doublespace
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,8 @@
+// Package duplicatedoc is the duplicate-doc check fixture.
+package duplicatedoc
+
+// Parse parses the config file and returns the result.
+func Parse() {}
+
+// Load parses the config file and returns the result. // want "copy of the Parse doc"
+func Load() {}
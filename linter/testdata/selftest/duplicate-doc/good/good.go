@@ -0,0 +1,8 @@
+// Package duplicatedoc is the known-good duplicate-doc check fixture.
+package duplicatedoc
+
+// Parse parses the config file and returns the result.
+func Parse() {}
+
+// Load loads the config from the disk and caches it.
+func Load() {}
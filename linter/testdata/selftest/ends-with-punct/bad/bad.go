@@ -0,0 +1,5 @@
+// Package endswithpunct is the ends-with-punct check fixture.
+package endswithpunct
+
+// Parse parses the config // want "should end with punctuation"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package endswithpunct is the known-good ends-with-punct check fixture.
+package endswithpunct
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,10 @@
+// Package enumdoc is the enum-doc check fixture.
+package enumdoc
+
+// Color is a color.
+type Color int
+
+const (
+	Red Color = iota // want "should have a block doc-comment"
+	Green
+)
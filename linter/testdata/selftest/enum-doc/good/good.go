@@ -0,0 +1,11 @@
+// Package enumdoc is the known-good enum-doc check fixture.
+package enumdoc
+
+// Color is a color.
+type Color int
+
+// The Color values.
+const (
+	Red Color = iota
+	Green
+)
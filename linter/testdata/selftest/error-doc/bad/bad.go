@@ -0,0 +1,5 @@
+// Package errordoc is the error-doc check fixture.
+package errordoc
+
+// Open opens the named file.
+func Open(name string) error { return nil } // want "should describe when it fails"
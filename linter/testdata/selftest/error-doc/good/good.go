@@ -0,0 +1,5 @@
+// Package errordoc is the known-good error-doc check fixture.
+package errordoc
+
+// Open opens the named file. It fails if the file doesn't exist.
+func Open(name string) error { return nil }
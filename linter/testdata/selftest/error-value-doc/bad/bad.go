@@ -0,0 +1,7 @@
+// Package errorvaluedoc is the error-value-doc check fixture.
+package errorvaluedoc
+
+import "errors"
+
+// ErrClosed error.
+var ErrClosed = errors.New("closed") // want "should say when the error is returned"
@@ -0,0 +1,7 @@
+// Package errorvaluedoc is the known-good error-value-doc check fixture.
+package errorvaluedoc
+
+import "errors"
+
+// ErrClosed is returned when the file is used after Close.
+var ErrClosed = errors.New("closed")
@@ -0,0 +1,5 @@
+// Package fillerword is the filler-word check fixture.
+package fillerword
+
+// Close simply closes the file. // want "\"simply\" is a filler word"
+func Close() {}
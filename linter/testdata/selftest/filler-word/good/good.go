@@ -0,0 +1,5 @@
+// Package fillerword is the known-good filler-word check fixture.
+package fillerword
+
+// Close closes the file. It's safe to call it twice.
+func Close() {}
@@ -0,0 +1,5 @@
+// Package firstparagraph is the first-paragraph check fixture.
+package firstparagraph
+
+// Parse parses the config. It reads the file. It decodes the JSON. It checks the values. // want "first paragraph has 4 sentences"
+func Parse() {}
@@ -0,0 +1,7 @@
+// Package firstparagraph is the known-good first-paragraph check fixture.
+package firstparagraph
+
+// Parse parses the config.
+//
+// It reads the file. It decodes the JSON. It checks the values.
+func Parse() {}
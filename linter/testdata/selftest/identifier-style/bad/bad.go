@@ -0,0 +1,11 @@
+// Package identifierstyle is the identifier-style check fixture.
+package identifierstyle
+
+// Parse parses the config, see `Load`. // want "Load is mentioned in quoted style, package style is link"
+func Parse() {}
+
+// Load loads the config, see [Parse].
+func Load() {}
+
+// Save saves the config, see [Parse].
+func Save() {}
@@ -0,0 +1,8 @@
+// Package identifierstyle is the known-good identifier-style check fixture.
+package identifierstyle
+
+// Parse parses the config, see [Load].
+func Parse() {}
+
+// Load loads the config, see [Parse].
+func Load() {}
@@ -0,0 +1,3 @@
+// Package importcomment is the import-comment check fixture.
+package importcomment
+// import "example.com/importcomment" // want "must follow the package clause on the same line"
@@ -0,0 +1,2 @@
+// Package importcomment is the known-good import-comment check fixture.
+package importcomment // import "github.com/Quasilyte/doccheck/linter/testdata/selftest/import-comment/good"
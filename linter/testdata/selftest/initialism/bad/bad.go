@@ -0,0 +1,5 @@
+// Package initialism is the initialism check fixture.
+package initialism
+
+// Fetch fetches the Url. // want "Url is an initialism, spell it as URL"
+func Fetch() {}
@@ -0,0 +1,5 @@
+// Package initialism is the known-good initialism check fixture.
+package initialism
+
+// Fetch fetches the URL by its ID.
+func Fetch() {}
@@ -0,0 +1,14 @@
+// Package interfacedocdup is the interface-doc-dup check fixture.
+package interfacedocdup
+
+// Sizer has a size.
+type Sizer interface {
+	// Size returns the size.
+	Size() int
+}
+
+// File is a file.
+type File struct{}
+
+// Size returns the size. // want "duplicates Sizer.Size doc"
+func (f *File) Size() int { return 0 }
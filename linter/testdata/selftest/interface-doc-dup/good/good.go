@@ -0,0 +1,14 @@
+// Package interfacedocdup is the known-good interface-doc-dup check fixture.
+package interfacedocdup
+
+// Sizer has a size.
+type Sizer interface {
+	// Size returns the size.
+	Size() int
+}
+
+// File is a file.
+type File struct{}
+
+// Size returns the file size in bytes.
+func (f *File) Size() int { return 0 }
@@ -0,0 +1,2 @@
+// Copyright 2024 The Authors. All rights reserved. // want "license header is the package doc"
+package licenseheader
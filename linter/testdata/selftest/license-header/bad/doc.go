@@ -0,0 +1,2 @@
+// Package licenseheader is the license-header check fixture.
+package licenseheader
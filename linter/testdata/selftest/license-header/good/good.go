@@ -0,0 +1,4 @@
+// Copyright 2024 The Authors. All rights reserved.
+
+// Package licenseheader is the known-good license-header check fixture.
+package licenseheader
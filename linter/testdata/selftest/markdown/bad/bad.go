@@ -0,0 +1,5 @@
+// Package markdown is the markdown check fixture.
+package markdown
+
+// Parse parses the **config**. // want "\\*\\*config\\*\\* is rendered verbatim"
+func Parse() {}
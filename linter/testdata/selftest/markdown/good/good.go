@@ -0,0 +1,8 @@
+// Package markdown is the known-good markdown check fixture.
+package markdown
+
+// Parse parses the config, see [Load].
+func Parse() {}
+
+// Load loads the config.
+func Load() {}
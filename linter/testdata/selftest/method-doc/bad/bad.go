@@ -0,0 +1,8 @@
+// Package methoddoc is the method-doc check fixture.
+package methoddoc
+
+// Buffer is a buffer.
+type Buffer struct{}
+
+// Buffer.Len returns the number of unread bytes. // want "should start with the method name Len"
+func (b *Buffer) Len() int { return 0 }
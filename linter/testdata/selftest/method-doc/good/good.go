@@ -0,0 +1,14 @@
+// Package methoddoc is the known-good method-doc check fixture.
+package methoddoc
+
+// Buffer is a buffer.
+type Buffer struct{}
+
+// Len returns the number of unread bytes.
+func (b *Buffer) Len() int { return 0 }
+
+// Error is an error.
+type Error string
+
+// Error returns the error message.
+func (e Error) Error() string { return string(e) }
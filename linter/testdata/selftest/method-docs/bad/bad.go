@@ -0,0 +1,10 @@
+// Package methoddocs is the method-docs check fixture.
+package methoddocs
+
+// Buffer is a buffer.
+type Buffer struct{} // want "1 of 2 methods undocumented: Len"
+
+func (b *Buffer) Len() int { return 0 }
+
+// Reset resets the buffer.
+func (b *Buffer) Reset() {}
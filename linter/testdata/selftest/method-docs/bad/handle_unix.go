@@ -0,0 +1,11 @@
+//go:build unix
+
+package methoddocs
+
+// Handle is a file handle.
+type Handle struct{ fd int } // want "type Handle: 1 of 2 methods undocumented: Close"
+
+func (h *Handle) Close() error { return nil }
+
+// Fd returns the file descriptor.
+func (h *Handle) Fd() int { return h.fd }
@@ -0,0 +1,10 @@
+//go:build windows
+
+package methoddocs
+
+// Handle is a file handle.
+type Handle struct{ fd uintptr }
+
+func (h *Handle) Close() error { return nil }
+
+func (h *Handle) Fd() int { return int(h.fd) }
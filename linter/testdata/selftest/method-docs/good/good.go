@@ -0,0 +1,11 @@
+// Package methoddocs is the known-good method-docs check fixture.
+package methoddocs
+
+// Buffer is a buffer.
+type Buffer struct{}
+
+// Len returns the number of unread bytes.
+func (b *Buffer) Len() int { return 0 }
+
+// Reset resets the buffer.
+func (b *Buffer) Reset() {}
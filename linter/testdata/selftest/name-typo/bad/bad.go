@@ -0,0 +1,5 @@
+// Package nametypo is the name-typo check fixture.
+package nametypo
+
+// Procesx handles the request. // want "is it a stale or misspelled Process"
+func Process() {}
@@ -0,0 +1,11 @@
+// Package nametypo is the known-good name-typo check fixture.
+package nametypo
+
+// Process handles the request.
+func Process() {}
+
+// BroadcastInt64 broadcasts the int64 values.
+func BroadcastInt64s() {}
+
+// ProcessBatch processes the batches.
+func ProcessBatches() {}
@@ -0,0 +1,5 @@
+// Package nomultiline is the no-multiline check fixture.
+package nomultiline
+
+/* Parse parses the config. */ // want "should not use /\\*\\*/ comments"
+func Parse() {}
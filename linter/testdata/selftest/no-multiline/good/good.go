@@ -0,0 +1,5 @@
+// Package nomultiline is the known-good no-multiline check fixture.
+package nomultiline
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1 @@
+package packagedoc // want "no doc-comment found"
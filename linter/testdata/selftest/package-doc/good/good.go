@@ -0,0 +1,5 @@
+// Package packagedoc is the known-good package-doc check fixture.
+package packagedoc
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,2 @@
+// The package-prefix check fixture. // want "should start with \"Package packageprefix\""
+package packageprefix
@@ -0,0 +1,5 @@
+// Package packageprefix is the known-good package-prefix check fixture.
+package packageprefix
+
+// Parse parses the config.
+func Parse() {}
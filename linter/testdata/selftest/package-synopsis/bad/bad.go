@@ -0,0 +1,2 @@
+// Package packagesynopsis is the package-synopsis check fixture // want "should be a sentence that ends with a period"
+package packagesynopsis
@@ -0,0 +1,5 @@
+// Package packagesynopsis is the known-good package-synopsis check fixture.
+package packagesynopsis
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,24 @@
+// Package panicdoc is the panic-doc check fixture.
+package panicdoc
+
+// MustParse parses the config.
+func MustParse(s string) { // want "should describe when it panics"
+	panic(s)
+}
+
+// Check checks the value.
+func Check(v int) { // want "panics at line 12"
+	if v < 0 {
+		panic("negative")
+	}
+}
+
+// Limit limits the value.
+func Limit(v int) { // want "panics at lines 19, 22"
+	if v < 0 {
+		panic("negative")
+	}
+	if v > 9 {
+		panic("too big")
+	}
+}
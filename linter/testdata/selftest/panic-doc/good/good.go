@@ -0,0 +1,21 @@
+// Package panicdoc is the known-good panic-doc check fixture.
+package panicdoc
+
+// MustParse is like Parse but panics if s is empty.
+func MustParse(s string) {
+	if s == "" {
+		panic("empty")
+	}
+}
+
+// Check checks the value, it panics if the value is negative.
+func Check(v int) {
+	if v < 0 {
+		panic("negative")
+	}
+}
+
+// Run runs the task.
+func Run() {
+	panic("unreachable")
+}
@@ -0,0 +1,8 @@
+// Package paramref is the param-ref check fixture.
+package paramref
+
+// Load loads the config from the cfg argument. // want "mentions cfg, but Load has no such parameter"
+func Load(path string) {}
+
+// Copy copies the file to dstPath. // want "mentions dstPath, but Copy has no such parameter"
+func Copy(destPath string) {}
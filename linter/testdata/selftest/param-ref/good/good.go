@@ -0,0 +1,14 @@
+// Package paramref is the known-good param-ref check fixture.
+package paramref
+
+// Load loads the config from the path file.
+func Load(path string) {}
+
+// Open opens the path, on macOS and iOS too.
+func Open(path string) {}
+
+// Update updates the balance in a transaction:
+//
+//	updateMoney, err := db.Prepare("UPDATE balance SET money=money+? WHERE id=?")
+//	tx.Stmt(updateMoney).Exec(123.45, 98293203)
+func Update(id int) {}
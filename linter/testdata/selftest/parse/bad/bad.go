@@ -0,0 +1,5 @@
+// Package parse is the parse check fixture.
+package parse
+
+func Broken( { // want "parse error: expected '\\)'"
+}
@@ -0,0 +1,5 @@
+// Package parse is the known-good parse check fixture.
+package parse
+
+// Parse parses the config.
+func Parse() {}
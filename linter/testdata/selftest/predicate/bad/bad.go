@@ -0,0 +1,5 @@
+// Package predicate is the predicate check fixture.
+package predicate
+
+// IsEmpty returns true if the list is empty. // want "bad predicate comment"
+func IsEmpty() bool { return true }
@@ -0,0 +1,8 @@
+// Package predicate is the known-good predicate check fixture.
+package predicate
+
+// IsEmpty reports whether the list is empty.
+func IsEmpty() bool { return true }
+
+// Lookup returns the value of the key and whether it exists.
+func Lookup(key string) (int, bool) { return 0, false }
@@ -0,0 +1,8 @@
+// Package receivername is the receiver-name check fixture.
+package receivername
+
+// Buffer is a buffer.
+type Buffer struct{ buf []byte }
+
+// Reset clears this.buf. // want "refer to the receiver as b"
+func (b *Buffer) Reset() {}
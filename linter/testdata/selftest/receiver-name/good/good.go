@@ -0,0 +1,8 @@
+// Package receivername is the known-good receiver-name check fixture.
+package receivername
+
+// Buffer is a buffer.
+type Buffer struct{ buf []byte }
+
+// Reset clears b.buf.
+func (b *Buffer) Reset() {}
@@ -0,0 +1,5 @@
+// Package repeatedword is the repeated-word check fixture.
+package repeatedword
+
+// Close closes the the file. // want "\"the\" is repeated"
+func Close() {}
@@ -0,0 +1,5 @@
+// Package repeatedword is the known-good repeated-word check fixture.
+package repeatedword
+
+// Close closes the file that had had the writes.
+func Close() {}
@@ -0,0 +1,5 @@
+// Package sentencecase is the sentence-case check fixture.
+package sentencecase
+
+// Parse parses the config. it fails on the empty input. // want "sentence starts with \"it\""
+func Parse(s string) {}
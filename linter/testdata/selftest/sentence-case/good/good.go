@@ -0,0 +1,16 @@
+// Package sentencecase is the known-good sentence-case check fixture.
+package sentencecase
+
+// Parse parses the config, e.g. the JSON one. It fails on the empty input.
+func Parse(s string) {}
+
+// Format formats the config as
+//
+//	key = value
+//
+// or in terms of the JSON, depending on the mode:
+//   - text
+//   - JSON
+//
+// depending on the extension of the file.
+func Format(s string) {}
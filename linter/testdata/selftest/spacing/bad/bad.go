@@ -0,0 +1,5 @@
+// Package spacing is the spacing check fixture.
+package spacing
+
+//Parse parses the config. // want "comment without leading space"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package spacing is the known-good spacing check fixture.
+package spacing
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,14 @@
+// Package spelling is the spelling check fixture.
+package spelling
+
+// Cancel cancels the request.
+func Cancel() {}
+
+// Canceled reports the canceled requests to the behavior hooks.
+func Canceled() {}
+
+// Watch watches the requests, the canceled ones are skipped.
+func Watch() {}
+
+// Stop stops the cancelled requests. // want "cancelled should be spelled canceled"
+func Stop() {}
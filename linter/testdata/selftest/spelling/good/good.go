@@ -0,0 +1,7 @@
+// Package spelling is the known-good spelling check fixture.
+package spelling
+
+// Cancel cancels the request.
+//
+// The canceled requests are reported to the behavior hooks.
+func Cancel() {}
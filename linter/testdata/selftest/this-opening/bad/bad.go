@@ -0,0 +1,5 @@
+// Package thisopening is the this-opening check fixture.
+package thisopening
+
+// This function parses the config. // want "instead of \"This function\""
+func Parse() {}
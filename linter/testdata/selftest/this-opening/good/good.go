@@ -0,0 +1,5 @@
+// Package thisopening is the known-good this-opening check fixture.
+package thisopening
+
+// Parse parses the config.
+func Parse() {}
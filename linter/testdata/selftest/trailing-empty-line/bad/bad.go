@@ -0,0 +1,6 @@
+// Package trailingemptyline is the trailing-empty-line check fixture.
+package trailingemptyline
+
+// Parse parses the config.
+// // want "ends with an empty line"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package trailingemptyline is the known-good trailing-empty-line check fixture.
+package trailingemptyline
+
+// Parse parses the config.
+func Parse() {}
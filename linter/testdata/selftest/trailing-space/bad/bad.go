@@ -0,0 +1,5 @@
+// Package trailingspace is the trailing-space check fixture.
+package trailingspace
+
+// Parse parses the config.  // want "has trailing whitespace"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package trailingspace is the known-good trailing-space check fixture.
+package trailingspace
+
+// Parse parses the config.
+func Parse() {}
@@ -0,0 +1,6 @@
+// Package typealias is the type-alias check fixture.
+package typealias
+
+import "bytes"
+
+type Reader = bytes.Reader // want "should have a doc-comment"
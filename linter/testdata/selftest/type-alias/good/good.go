@@ -0,0 +1,7 @@
+// Package typealias is the known-good type-alias check fixture.
+package typealias
+
+import "bytes"
+
+// Reader is an alias of bytes.Reader kept for compatibility.
+type Reader = bytes.Reader
@@ -0,0 +1,5 @@
+// Package typeparamdoc is the type-param-doc check fixture.
+package typeparamdoc
+
+// Map is a map. // want "should describe the K, V type parameters"
+type Map[K comparable, V any] struct{}
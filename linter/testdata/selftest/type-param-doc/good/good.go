@@ -0,0 +1,5 @@
+// Package typeparamdoc is the known-good type-param-doc check fixture.
+package typeparamdoc
+
+// Map maps the K keys to the V values.
+type Map[K comparable, V any] struct{}
@@ -0,0 +1,5 @@
+// Package typography is the typography check fixture.
+package typography
+
+// Parse parses the “config” — a JSON file. // want "non-ASCII punctuation \\(U\\+201C, U\\+201D, U\\+2014\\)"
+func Parse() {}
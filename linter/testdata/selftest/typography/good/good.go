@@ -0,0 +1,5 @@
+// Package typography is the known-good typography check fixture.
+package typography
+
+// Parse parses the "config", a JSON file.
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package url is the url check fixture.
+package url
+
+// Parse parses the config, see [the spec](https://go.dev/ref/spec). // want "Markdown links are not supported"
+func Parse() {}
@@ -0,0 +1,5 @@
+// Package url is the known-good url check fixture.
+package url
+
+// Parse parses the config, see https://go.dev/ref/spec for the syntax.
+func Parse() {}
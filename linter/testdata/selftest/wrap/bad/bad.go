@@ -0,0 +1,5 @@
+// Package wrap is the wrap check fixture.
+package wrap
+
+// Parse parses the config, the lines of this doc-comment are longer than the configured wrap width of the fixtures. // want "line is 116 characters long"
+func Parse() {}
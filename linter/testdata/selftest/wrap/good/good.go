@@ -0,0 +1,6 @@
+// Package wrap is the known-good wrap check fixture.
+package wrap
+
+// Parse parses the config, the lines of this doc-comment
+// fit the configured wrap width of the fixtures.
+func Parse() {}
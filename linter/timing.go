@@ -0,0 +1,122 @@
+package linter
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"slices"
+	"sync"
+	"time"
+)
+
+// timings collects the -debug=timing durations.
+// Check durations are summed over all packages.
+type timings struct {
+	mu       sync.Mutex
+	checks   map[string]time.Duration
+	packages map[string]time.Duration
+}
+
+func newTimings() *timings {
+	return &timings{
+		checks:   make(map[string]time.Duration),
+		packages: make(map[string]time.Duration),
+	}
+}
+
+// timed runs the check and records its duration under the given name.
+func (c *checker) timed(name string, check func()) {
+	if c.timings == nil {
+		check()
+		return
+	}
+	start := time.Now()
+	check()
+	c.timings.mu.Lock()
+	c.timings.checks[name] += time.Since(start)
+	c.timings.mu.Unlock()
+}
+
+// timePackage starts measuring the package directory check.
+// The returned function stops it.
+func (l *linter) timePackage(path string) func() {
+	if l.timings == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		l.timings.mu.Lock()
+		l.timings.packages[path] += time.Since(start)
+		l.timings.mu.Unlock()
+	}
+}
+
+// Print writes the durations, the slowest first.
+func (t *timings) Print(w io.Writer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(w, "time per check:\n")
+	printDurations(w, t.checks)
+	fmt.Fprintf(w, "time per package:\n")
+	printDurations(w, t.packages)
+}
+
+func printDurations(w io.Writer, durations map[string]time.Duration) {
+	keys := sortedKeys(durations)
+	slices.SortStableFunc(keys, func(a, b string) int {
+		return cmp.Compare(durations[b], durations[a])
+	})
+	for _, k := range keys {
+		fmt.Fprintf(w, "  %10s  %s\n", durations[k].Round(time.Microsecond), k)
+	}
+}
+
+// profiles are the -cpuprofile and -memprofile outputs.
+type profiles struct {
+	cpu *os.File
+	mem string
+}
+
+// startProfiles starts the CPU profiling if cpu is not empty.
+// The memory profile is written by stop.
+func startProfiles(cpu, mem string) (*profiles, error) {
+	p := &profiles{mem: mem}
+	if cpu != "" {
+		f, err := os.Create(cpu)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		p.cpu = f
+	}
+	return p, nil
+}
+
+// stop finishes the CPU profile and writes the memory profile.
+func (p *profiles) stop() error {
+	if p.cpu != nil {
+		pprof.StopCPUProfile()
+		if err := p.cpu.Close(); err != nil {
+			return err
+		}
+	}
+	if p.mem == "" {
+		return nil
+	}
+	f, err := os.Create(p.mem)
+	if err != nil {
+		return err
+	}
+	runtime.GC() // Up-to-date allocation statistics.
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/types"
+)
+
+// typeCheck collects the type information for the packages of the directory.
+// The packages get their import paths, if they're known. The imports are
+// resolved by the go tool, so in a workspace they may refer to the
+// packages of the other workspace modules.
+//
+// Type errors are not fatal: the results are used only to refine
+// the AST-based heuristics, so partial information is still useful.
+func (l *linter) typeCheck(path, importPath string, packages map[string]*ast.Package) *types.Info {
+	info := &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+	}
+	// Source importer is not safe for a concurrent use,
+	// so every package directory gets its own.
+	imp := importer.ForCompiler(l.fset, "source", nil)
+	for _, pkg := range packages {
+		conf := types.Config{
+			Importer:    imp,
+			FakeImportC: true,
+			Error: func(err error) {
+				l.logger.Debug("type error", "path", path, "err", err)
+			},
+		}
+		pkgPath := path
+		if importPath != "" {
+			pkgPath = importPath
+		}
+		conf.Check(pkgPath, l.fset, sortedFiles(pkg), info)
+	}
+	return info
+}
+
+// funcSignature returns the type of the declared function
+// or nil if the type information is unavailable.
+func (c *checker) funcSignature(decl *ast.FuncDecl) *types.Signature {
+	if c.types == nil {
+		return nil
+	}
+	fn, ok := c.types.Defs[decl.Name].(*types.Func)
+	if !ok {
+		return nil
+	}
+	return fn.Type().(*types.Signature)
+}
+
+// isBoolType reports whether typ is the predeclared bool type.
+// Aliases of bool are bool too, but defined types like
+// "type Tristate bool" are not, as they usually carry
+// more than a yes or no answer.
+func isBoolType(typ types.Type) bool {
+	return types.Identical(typ, types.Typ[types.Bool])
+}
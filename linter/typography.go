@@ -0,0 +1,51 @@
+package linter
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// typographicReplacements maps the word processor punctuation
+// to the ASCII equivalents.
+var typographicReplacements = []string{
+	"“", `"`, // Left double quotation mark.
+	"”", `"`, // Right double quotation mark.
+	"‘", "'", // Left single quotation mark.
+	"’", "'", // Right single quotation mark.
+	"—", "--", // Em dash.
+	"–", "-", // En dash.
+	"…", "...", // Horizontal ellipsis.
+	"\u00a0", " ", // No-break space.
+}
+
+var typographicReplacer = strings.NewReplacer(typographicReplacements...)
+
+// checkTypography reports typographic quotes, dashes and no-break spaces
+// in doc-comments. They render inconsistently, and the docs can't be
+// searched for the quoted text with the ASCII quotes.
+func (c *checker) checkTypography(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		fixed := typographicReplacer.Replace(comment.Text)
+		if fixed == comment.Text {
+			continue
+		}
+
+		var found []string
+		for i := 0; i < len(typographicReplacements); i += 2 {
+			if strings.Contains(comment.Text, typographicReplacements[i]) {
+				found = append(found, fmt.Sprintf("%U", []rune(typographicReplacements[i])[0]))
+			}
+		}
+		pos := c.fset.Position(comment.Pos())
+		c.suggestFix(textEdit{
+			check:    "typography",
+			filename: pos.Filename,
+			start:    pos.Offset,
+			end:      pos.Offset + len(comment.Text),
+			newText:  fixed,
+		})
+		c.warnComment("typography", comment, "doc-comment contains non-ASCII punctuation (%s), use ASCII equivalents",
+			strings.Join(found, ", "))
+	}
+}
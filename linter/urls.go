@@ -0,0 +1,78 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+var (
+	// urlRegexp matches the URLs roughly the way go/doc auto-links them.
+	urlRegexp = regexp.MustCompile(`\b(?:https?|ftp)://[^\s<>"` + "`" + `]+`)
+
+	// markdownLinkRegexp matches the [text](url) Markdown links.
+	markdownLinkRegexp = regexp.MustCompile(`\[[^\]]+\]\((?:https?|ftp)://[^)\s]+\)`)
+
+	// urlContinuationRegexp matches the lines that are likely
+	// the rest of a URL from the previous line.
+	urlContinuationRegexp = regexp.MustCompile(`^[/?&#=%][^\s]*`)
+)
+
+// checkURLs reports the doc-comment URLs that pkg.go.dev can't auto-link:
+// Markdown links, URLs wrapped across lines or with glued punctuation.
+// It also reports the godoc.org links, which only redirect to pkg.go.dev.
+func (c *checker) checkURLs(doc *ast.CommentGroup) {
+	for i, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") || isDirective(comment.Text) {
+			continue
+		}
+		text := comment.Text[len("//"):]
+
+		if markdownLinkRegexp.MatchString(text) {
+			c.warnComment("url", comment, "Markdown links are not supported, use a [text] link with the \"[text]: URL\" definition")
+			continue
+		}
+
+		for _, loc := range urlRegexp.FindAllStringIndex(text, -1) {
+			u := trimURLPunct(text[loc[0]:loc[1]])
+			switch {
+			case strings.Contains(u, "godoc.org"):
+				c.warnComment("url", comment, "%s refers to godoc.org, use https://pkg.go.dev instead", u)
+			case strings.HasPrefix(u, "http://") && !isLocalURL(u):
+				c.warnComment("url", comment, "%s should use https", u)
+			case strings.ContainsAny(u[len(u)-1:], `'*_`):
+				c.warnComment("url", comment, "%s has trailing %q glued to it, it will be a part of the link", u, u[len(u)-1:])
+			case loc[1] == len(text) && i+1 < len(doc.List):
+				next := strings.TrimPrefix(doc.List[i+1].Text, "//")
+				if urlContinuationRegexp.MatchString(strings.TrimSpace(next)) {
+					c.warnComment("url", comment, "%s seems to be wrapped across lines, keep it on a single line", u)
+				}
+			}
+		}
+	}
+}
+
+// trimURLPunct removes the trailing punctuation that go/doc
+// doesn't consider a part of the URL, like the sentence period.
+func trimURLPunct(u string) string {
+	for {
+		trimmed := strings.TrimRight(u, ".,:;?!")
+		if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, "(") < strings.Count(trimmed, ")") {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if trimmed == u {
+			return u
+		}
+		u = trimmed
+	}
+}
+
+func isLocalURL(u string) bool {
+	host := strings.TrimPrefix(u, "http://")
+	for _, local := range []string{"localhost", "127.0.0.1", "[::1]", "example.com", "example.org"} {
+		if strings.HasPrefix(host, local) {
+			return true
+		}
+	}
+	return false
+}
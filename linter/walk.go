@@ -0,0 +1,188 @@
+package linter
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// skipDir reports whether the directory is skipped during the traversal.
+// As in the go tool, these are vendor, testdata and the directories
+// starting with "." or "_", unless the -include flags are set.
+func (l *linter) skipDir(name string) bool {
+	switch {
+	case name == "vendor":
+		return !l.includeVendor
+	case name == "testdata":
+		return !l.includeTestdata
+	case strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_"):
+		return !l.includeHidden
+	}
+	return false
+}
+
+// expandPaths replaces the "dir/..." paths with all
+// package directories under dir, including dir itself.
+// The Go file paths are replaced with their directories, only the given
+// files of such directories are checked. Other paths are returned as is.
+//
+// When dir is a workspace root, the pattern matches the packages
+// of all workspace modules instead, including the ones outside
+// of dir. As in the go tool, a nested module, a directory with its
+// own go.mod file, is not a part of the enclosing one, so it's not
+// matched unless it's a workspace module. Only the dirs outside
+// of any module match all packages under them.
+func (l *linter) expandPaths(paths []string) ([]string, error) {
+	var expanded []string
+	for _, path := range paths {
+		if isGoFile(path) {
+			dir := filepath.Dir(path)
+			if l.listedFiles[dir] == nil {
+				if l.listedFiles == nil {
+					l.listedFiles = make(map[string]map[string]bool)
+				}
+				l.listedFiles[dir] = make(map[string]bool)
+				expanded = append(expanded, dir)
+			}
+			l.listedFiles[dir][filepath.Base(path)] = true
+			continue
+		}
+		root, ok := strings.CutSuffix(filepath.ToSlash(path), "...")
+		if !ok {
+			expanded = append(expanded, path)
+			continue
+		}
+		root = filepath.FromSlash(strings.TrimSuffix(root, "/"))
+		if root == "" {
+			root = "."
+		}
+		roots := []string{root}
+		if gowork := workspaceFile(root); gowork != "" {
+			dirs, err := readWorkspaceModules(gowork)
+			if err != nil {
+				return nil, err
+			}
+			l.logger.Info("expanding the workspace modules", "file", gowork, "modules", len(dirs))
+			roots = dirs
+		}
+		for _, root := range roots {
+			dirs, err := l.walkPackages(root)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, dirs...)
+		}
+	}
+	return expanded, nil
+}
+
+// walkPackages returns the package directories under root. If root
+// is in a module, the directories of the nested modules are skipped.
+func (l *linter) walkPackages(root string) ([]string, error) {
+	inModule := moduleImportPath(root) != ""
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && (l.skipDir(d.Name()) || inModule && hasGoMod(path)) {
+			l.logger.Debug("skipping directory", "path", path)
+			return filepath.SkipDir
+		}
+		if hasGoFiles(path) {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+// hasGoMod reports whether the dir is a module root.
+func hasGoMod(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "go.mod"))
+	return err == nil
+}
+
+// isGoFile reports whether the path is a Go file rather than a directory.
+func isGoFile(path string) bool {
+	if !strings.HasSuffix(path, ".go") {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// readResponseFiles replaces the "@file" arguments with the lines of
+// the files, so the build systems can pass the lists of the Go files
+// and packages that don't fit the command line. Empty lines and the
+// lines starting with # are ignored.
+//
+// The "@file" lines are replaced with the lines of their files too.
+// The Go-quoted lines, like "dir/a b.go", are always the paths,
+// so the paths may contain spaces or start with @ or #.
+func readResponseFiles(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		filename, ok := strings.CutPrefix(arg, "@")
+		if !ok {
+			paths = append(paths, arg)
+			continue
+		}
+		lines, err := readResponseFile(filename, nil)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, lines...)
+	}
+	return paths, nil
+}
+
+// readResponseFile returns the paths of the response file, the nested
+// response files included. The parents are the files that include it.
+func readResponseFile(filename string, parents []string) ([]string, error) {
+	if slices.Contains(parents, filepath.Clean(filename)) {
+		return nil, fmt.Errorf("%s: the response file includes itself", filename)
+	}
+	parents = append(parents, filepath.Clean(filename))
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var paths []string
+	s := bufio.NewScanner(f)
+	for lineNum := 1; s.Scan(); lineNum++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line[0] == '"' || line[0] == '`' {
+			path, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: bad quoted path %s", filename, lineNum, line)
+			}
+			paths = append(paths, path)
+			continue
+		}
+		if nested, ok := strings.CutPrefix(line, "@"); ok {
+			lines, err := readResponseFile(nested, parents)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, lines...)
+			continue
+		}
+		paths = append(paths, line)
+	}
+	return paths, s.Err()
+}
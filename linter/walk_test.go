@@ -0,0 +1,145 @@
+package linter
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestExpandPathsNestedModules(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":             "module example.com/outer\n",
+		"a.go":               "package a\n",
+		"pkg/b.go":           "package b\n",
+		"nested/go.mod":      "module example.com/nested\n",
+		"nested/c.go":        "package c\n",
+		"nested/sub/d.go":    "package d\n",
+		"plain/go.mod.txt":   "not a go.mod file\n",
+		"plain/e.go":         "package e\n",
+		"testdata/skip.go":   "package skip\n",
+		"nested2/go.mod":     "module example.com/nested2\n",
+		"nested2/inner/f.go": "package f\n",
+	}
+	for name, data := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("GOWORK", "")
+
+	l := &linter{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	check := func(pattern string, want ...string) {
+		t.Helper()
+		have, err := l.expandPaths([]string{filepath.Join(dir, pattern)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range want {
+			want[i] = filepath.Join(dir, filepath.FromSlash(want[i]))
+		}
+		if !slices.Equal(have, want) {
+			t.Errorf("%s:\nhave %q\nwant %q", pattern, have, want)
+		}
+	}
+	check("...", ".", "pkg", "plain")
+	check("nested/...", "nested", "nested/sub")
+
+	if err := os.Remove(filepath.Join(dir, "go.mod")); err != nil {
+		t.Fatal(err)
+	}
+	// Outside of any module, all packages are matched.
+	check("...", ".", "nested", "nested/sub", "nested2/inner", "pkg", "plain")
+}
+
+func TestReadResponseFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		args  []string
+		want  []string
+		err   string
+	}{
+		{
+			name:  "lines",
+			files: map[string]string{"a.txt": "a.go\n\n  # comment\n  ./pkg/...  \n"},
+			args:  []string{"x.go", "@a.txt", "y"},
+			want:  []string{"x.go", "a.go", "./pkg/...", "y"},
+		},
+		{
+			name:  "quoted",
+			files: map[string]string{"a.txt": "\"dir/a b.go\"\n`@not-a-file`\n\"#not-a-comment\"\n\"tab\\t.go\"\n"},
+			args:  []string{"@a.txt"},
+			want:  []string{"dir/a b.go", "@not-a-file", "#not-a-comment", "tab\t.go"},
+		},
+		{
+			name:  "bad quoting",
+			files: map[string]string{"a.txt": "a.go\n\"b.go\n"},
+			args:  []string{"@a.txt"},
+			err:   "a.txt:2: bad quoted path",
+		},
+		{
+			name: "nested",
+			files: map[string]string{
+				"a.txt": "a.go\n@{dir}/b.txt\nc.go\n",
+				"b.txt": "b.go\n@{dir}/c.txt\n",
+				"c.txt": "# empty\n",
+			},
+			args: []string{"@a.txt", "@c.txt", "@b.txt"},
+			want: []string{"a.go", "b.go", "c.go", "b.go"},
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"a.txt": "@{dir}/b.txt\n",
+				"b.txt": "@{dir}/./a.txt\n",
+			},
+			args: []string{"@a.txt"},
+			err:  "the response file includes itself",
+		},
+		{
+			name: "missing",
+			args: []string{"@a.txt"},
+			err:  "no such file",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, data := range test.files {
+				data = strings.ReplaceAll(data, "{dir}", dir)
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var args []string
+			for _, arg := range test.args {
+				if filename, ok := strings.CutPrefix(arg, "@"); ok {
+					arg = "@" + filepath.Join(dir, filename)
+				}
+				args = append(args, arg)
+			}
+			have, err := readResponseFiles(args)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("have %v error, want %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(have, test.want) {
+				t.Errorf("have %q\nwant %q", have, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package linter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// wantRegexp matches the "// want" annotation at the end of a fixture line.
+var wantRegexp = regexp.MustCompile("[ \\t]?// want ([\"`].*)$")
+
+// want is an expected diagnostic of a fixture line.
+type want struct {
+	pos     token.Position
+	re      *regexp.Regexp
+	matched bool
+}
+
+// CheckFixtures checks the Go files under the dir, every directory is a
+// package, and compares the diagnostics with the expected ones. It returns
+// the mismatches, one per line, like
+//
+//	a.go:5: unexpected diagnostic: bad predicate comment (predicate)
+//	a.go:9: no diagnostic matching "unclosed code span"
+//
+// The expected diagnostics are the "// want" annotations that end the
+// lines, with one or more Go string literals of the message regexps:
+//
+//	// IsOK tells whether it's ok. // want "bad predicate comment"
+//
+// The annotations are removed before the check with the one space or tab
+// before them, so they can follow the doc-comment lines too, and the rest
+// of the whitespace is kept for the trailing-space fixtures.
+//
+// The diagnostics without a line are expected at the package clause line
+// of their file, the package ones at the one of the first package file.
+// The files that can't be parsed get the parse diagnostic at their first
+// syntax error.
+func CheckFixtures(dir string, opts Options) ([]string, error) {
+	return checkFixtures(os.DirFS(dir), dir, opts, true)
+}
+
+// checkFixtures is like CheckFixtures for the files of the fsys,
+// the file names are joined to the dir. The module import paths and
+// the words files of the dir are looked up on disk only with onDisk,
+// the fixtures of the embedded fsys have neither.
+func checkFixtures(fsys fs.FS, dir string, opts Options, onDisk bool) ([]string, error) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	var wants []*want
+	var parseErrors []Diagnostic
+	packageLines := make(map[string]int)
+	firstFiles := make(map[string]string)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(name, ".go") {
+			return err
+		}
+		src, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		src, fileWants, err := stripWants(path, src)
+		if err != nil {
+			return err
+		}
+		wants = append(wants, fileWants...)
+		f, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+		if list, ok := err.(scanner.ErrorList); ok && len(list) != 0 {
+			parseErrors = append(parseErrors, Diagnostic{
+				Check:   "parse",
+				Pos:     list[0].Pos,
+				Message: fmt.Sprintf("parse error: %s", list[0].Msg),
+			})
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		packageLines[path] = fset.Position(f.Package).Line
+		if _, ok := firstFiles[filepath.Dir(path)]; !ok {
+			firstFiles[filepath.Dir(path)] = path
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics, err := lint(fset, files, opts, onDisk)
+	if err != nil {
+		return nil, err
+	}
+	var mismatches []string
+	for _, d := range append(parseErrors, diagnostics...) {
+		filename, line := d.Pos.Filename, d.Pos.Line
+		if line == 0 {
+			if first, ok := firstFiles[filename]; ok {
+				filename = first
+			}
+			line = packageLines[filename]
+		}
+		if !matchWant(wants, filename, line, d.Message) {
+			mismatches = append(mismatches, fmt.Sprintf("%s:%d: unexpected diagnostic: %s (%s)", filename, line, d.Message, d.Check))
+		}
+	}
+	for _, w := range wants {
+		if !w.matched {
+			mismatches = append(mismatches, fmt.Sprintf("%s:%d: no diagnostic matching %q", w.pos.Filename, w.pos.Line, w.re))
+		}
+	}
+	return mismatches, nil
+}
+
+// matchWant marks the first unmatched want of the line that
+// matches the message and reports whether there is one.
+func matchWant(wants []*want, filename string, line int, message string) bool {
+	for _, w := range wants {
+		if !w.matched && w.pos.Filename == filename && w.pos.Line == line && w.re.MatchString(message) {
+			w.matched = true
+			return true
+		}
+	}
+	return false
+}
+
+// stripWants removes the "// want" annotations from the source and
+// returns their expectations. The other lines are kept as they are,
+// so the positions don't change.
+func stripWants(filename string, src []byte) ([]byte, []*want, error) {
+	lines := strings.Split(string(src), "\n")
+	var wants []*want
+	for i, line := range lines {
+		loc := wantRegexp.FindStringSubmatchIndex(line)
+		if loc == nil {
+			continue
+		}
+		pos := token.Position{Filename: filename, Line: i + 1}
+		patterns, err := parseWantPatterns(line[loc[2]:loc[3]])
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s:%d: %v", filename, pos.Line, err)
+		}
+		for _, re := range patterns {
+			wants = append(wants, &want{pos: pos, re: re})
+		}
+		lines[i] = line[:loc[0]]
+	}
+	return []byte(strings.Join(lines, "\n")), wants, nil
+}
+
+// parseWantPatterns parses the space-separated Go string
+// literals of the annotation as regexps.
+func parseWantPatterns(s string) ([]*regexp.Regexp, error) {
+	var patterns []*regexp.Regexp
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		lit, err := strconv.QuotedPrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("want: expected a string literal at %q", s)
+		}
+		pattern, err := strconv.Unquote(lit)
+		if err != nil {
+			return nil, fmt.Errorf("want: %v", err)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("want: %v", err)
+		}
+		patterns = append(patterns, re)
+		s = s[len(lit):]
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("want: no patterns")
+	}
+	return patterns, nil
+}
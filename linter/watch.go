@@ -0,0 +1,84 @@
+package linter
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// watch checks paths and then re-checks the directories
+// whose Go files were changed, until the process is stopped.
+//
+// Directories are polled every interval instead of using
+// the OS notifications to stay dependency-free and portable.
+func (l *linter) watch(paths []string, interval time.Duration) {
+	snapshots := make(map[string]string, len(paths))
+	for _, path := range paths {
+		snapshots[path] = dirSnapshot(path)
+	}
+	l.recheck(paths)
+	fmt.Fprintf(os.Stderr, "watching %d directories for changes\n", len(paths))
+
+	for range time.Tick(interval) {
+		var changed []string
+		for _, path := range paths {
+			snapshot := dirSnapshot(path)
+			if snapshot != snapshots[path] {
+				snapshots[path] = snapshot
+				changed = append(changed, path)
+			}
+		}
+		if len(changed) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "\n[%s] re-checking %s\n", time.Now().Format(time.TimeOnly), strings.Join(changed, ", "))
+		// A fresh file set for every run, so the old file
+		// versions don't accumulate in the memory.
+		l.fset = token.NewFileSet()
+		l.recheck(changed)
+	}
+}
+
+// recheck checks the paths and prints the issues. The counters of the
+// previous runs are reset, so -max-issues limits every run separately.
+func (l *linter) recheck(paths []string) {
+	l.mu.Lock()
+	l.issues = 0
+	l.failures = 0
+	l.printed = 0
+	l.suppressed = 0
+	l.pending = l.pending[:0]
+	l.mu.Unlock()
+
+	l.Run(paths)
+	l.Flush()
+	if l.suppressed != 0 {
+		fmt.Fprintf(os.Stderr, "%d more issues are suppressed by -max-issues=%d\n", l.suppressed, l.maxIssues)
+	}
+}
+
+// dirSnapshot returns a string that changes whenever
+// a Go file of the directory is added, removed or modified.
+// Unreadable directories have an empty snapshot.
+func dirSnapshot(path string) string {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return ""
+	}
+	var sb strings.Builder
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s %d %d\n", filepath.Join(path, e.Name()), info.Size(), info.ModTime().UnixNano())
+	}
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package linter
+
+import (
+	"go/token"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecheckMaxIssues(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "p.go")
+	writeFile := func(src string) {
+		t.Helper()
+		if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	printer := &issueCollector{}
+	l := &linter{
+		fset:                       token.NewFileSet(),
+		maxFirstParagraphSentences: 3,
+		logger:                     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		printer:                    printer,
+		maxIssues:                  1,
+		maxIssuesStop:              true,
+	}
+	if err := l.applyConfig(defaultConfig(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	writeFile("// Package p is a test.\npackage p\n\n// A is ok\nfunc A() {}\n")
+	l.recheck([]string{dir})
+	writeFile("// Package p is a test.\npackage p\n\n// A is ok.\nfunc A() {}\n\n// B is ok\nfunc B() {}\n")
+	l.fset = token.NewFileSet()
+	l.recheck([]string{dir})
+
+	if len(printer.diagnostics) != 2 {
+		t.Fatalf("%d issues are printed, want 1 for every run: %v", len(printer.diagnostics), printer.diagnostics)
+	}
+	if name := printer.diagnostics[1].Name; name != "B" {
+		t.Errorf("the re-check printed the issue of %s, want B", name)
+	}
+	if l.failures != 1 {
+		t.Errorf("%d failures after the re-check, want 1", l.failures)
+	}
+}
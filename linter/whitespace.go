@@ -0,0 +1,103 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+)
+
+var (
+	// spaceRunRegexp matches the runs of two or more spaces.
+	spaceRunRegexp = regexp.MustCompile(`  +`)
+
+	// spanRegexp matches the code spans and the double-quoted text,
+	// their spacing is kept as is.
+	spanRegexp = regexp.MustCompile("`[^`]*`|\"[^\"]*\"")
+)
+
+// checkTrailingSpace reports doc-comment lines that end with
+// whitespace and the empty lines at the end of the doc-comment.
+// Both are editing artifacts that gofmt keeps as is.
+func (c *checker) checkTrailingSpace(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			continue
+		}
+		trimmed := strings.TrimRight(comment.Text, " \t")
+		if trimmed == comment.Text {
+			continue
+		}
+		pos := c.fset.Position(comment.Pos())
+		c.suggestFix(textEdit{
+			check:    "trailing-space",
+			filename: pos.Filename,
+			start:    pos.Offset + len(trimmed),
+			end:      pos.Offset + len(comment.Text),
+		})
+		c.warnComment("trailing-space", comment, "doc-comment line has trailing whitespace")
+	}
+
+	// Find the trailing empty lines, the first comment can't be removed.
+	last := len(doc.List)
+	for last > 1 && strings.TrimSpace(doc.List[last-1].Text) == "//" {
+		last--
+	}
+	if last == len(doc.List) {
+		return
+	}
+	from := c.fset.Position(doc.List[last-1].End())
+	to := c.fset.Position(doc.List[len(doc.List)-1].End())
+	c.suggestFix(textEdit{
+		check:    "trailing-empty-line",
+		filename: from.Filename,
+		start:    from.Offset,
+		end:      to.Offset,
+	})
+	c.warnComment("trailing-empty-line", doc.List[last], "doc-comment ends with an empty line")
+}
+
+// checkDoubleSpace reports the runs of spaces between the words of the
+// doc-comment prose, usually left by copy-pasting or the editors joining
+// the lines. Code blocks, lists, code spans and the double-quoted text
+// are not checked. With the "double" sentence_spacing, two spaces after
+// the sentence end are accepted.
+func (c *checker) checkDoubleSpace(doc *ast.CommentGroup) {
+	for _, comment := range doc.List {
+		text, ok := strings.CutPrefix(comment.Text, "//")
+		if !ok || isDirective(comment.Text) || isIndented(strings.TrimPrefix(text, " ")) {
+			continue
+		}
+		masked := spanRegexp.ReplaceAllStringFunc(comment.Text, func(s string) string {
+			return strings.Repeat("x", len(s))
+		})
+		pos := c.fset.Position(comment.Pos())
+		found := false
+		for _, loc := range spaceRunRegexp.FindAllStringIndex(masked, -1) {
+			if loc[1] == len(masked) {
+				continue // Reported by the trailing-space check.
+			}
+			if c.sentenceSpacing == "double" && loc[1]-loc[0] == 2 && endsSentence(masked[:loc[0]]) {
+				continue
+			}
+			c.suggestFix(textEdit{
+				check:    "double-space",
+				filename: pos.Filename,
+				start:    pos.Offset + loc[0],
+				end:      pos.Offset + loc[1],
+				newText:  " ",
+			})
+			found = true
+		}
+		if found {
+			c.warnComment("double-space", comment, "doc-comment has double spaces between the words, use a single space")
+		}
+	}
+}
+
+// endsSentence reports whether the text ends with
+// the sentence punctuation, maybe followed by a quote
+// or a closing parenthesis.
+func endsSentence(text string) bool {
+	text = strings.TrimRight(text, `"')`)
+	return strings.HasSuffix(text, ".") || strings.HasSuffix(text, "!") || strings.HasSuffix(text, "?")
+}
@@ -0,0 +1,95 @@
+package linter
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// wordsFile lists the words accepted by the prose checks
+// in the directory it's in and in all of its subdirectories.
+const wordsFile = ".doccheck-words"
+
+// wordList is a set of the accepted words, like the product names,
+// domain terms and the identifiers of the other packages.
+//
+// The lower case words are accepted in any case,
+// the others only exactly as they are spelled.
+type wordList struct {
+	words map[string]bool
+
+	// key identifies the list contents for the cache.
+	key string
+}
+
+// Has reports whether the word is accepted.
+func (w *wordList) Has(word string) bool {
+	if w == nil {
+		return false
+	}
+	return w.words[word] || w.words[strings.ToLower(word)]
+}
+
+// dirWords returns the words of the wordsFile files in the dir
+// and its parent directories, or nil if there are none.
+// The parsed files are remembered for the other packages.
+func (l *linter) dirWords(dir string) *wordList {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil
+	}
+
+	words := make(map[string]bool)
+	for d := abs; ; d = filepath.Dir(d) {
+		for _, word := range l.loadWordsFile(filepath.Join(d, wordsFile)) {
+			words[word] = true
+		}
+		if parent := filepath.Dir(d); parent == d {
+			break
+		}
+	}
+	if len(words) == 0 {
+		return nil
+	}
+	return &wordList{words: words, key: strings.Join(sortedKeys(words), "\n")}
+}
+
+// loadWordsFile returns the words of the file, one per line.
+// Empty lines and the lines starting with # are ignored.
+func (l *linter) loadWordsFile(filename string) []string {
+	l.mu.Lock()
+	words, ok := l.wordFiles[filename]
+	l.mu.Unlock()
+	if ok {
+		return words
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		l.logger.Warn("can't read the words file", "file", filename, "err", err)
+	}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	sort.Strings(words)
+	if len(words) != 0 {
+		l.logger.Info("loaded the words file", "file", filename, "words", len(words))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.wordFiles == nil {
+		l.wordFiles = make(map[string][]string)
+	}
+	l.wordFiles[filename] = words
+	return words
+}
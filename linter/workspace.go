@@ -0,0 +1,88 @@
+package linter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// workspaceFile returns the go.work file of the workspace rooted
+// at the dir, or "" if the dir is not a workspace root.
+//
+// As in the go tool, the GOWORK variable selects the file,
+// and GOWORK=off disables the workspace mode.
+func workspaceFile(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	switch gowork := os.Getenv("GOWORK"); gowork {
+	case "off":
+		return ""
+	case "":
+		filename := filepath.Join(dir, "go.work")
+		if _, err := os.Stat(filename); err != nil {
+			return ""
+		}
+		return filename
+	default:
+		file, err := filepath.Abs(gowork)
+		if err != nil || filepath.Dir(file) != abs {
+			return ""
+		}
+		return gowork
+	}
+}
+
+// readWorkspaceModules returns the module directories of the use
+// directives of the go.work file, relative to the file directory.
+func readWorkspaceModules(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var dirs []string
+	inBlock := false
+	s := bufio.NewScanner(f)
+	for lineNum := 1; s.Scan(); lineNum++ {
+		line := s.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		var dir string
+		switch {
+		case line == "":
+			continue
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case inBlock:
+			dir = line
+		case strings.HasPrefix(line, "use ") || strings.HasPrefix(line, "use\t") || strings.HasPrefix(line, "use("):
+			dir = strings.TrimSpace(line[len("use"):])
+			if dir == "(" {
+				inBlock = true
+				continue
+			}
+		default:
+			continue
+		}
+		if dir[0] == '"' || dir[0] == '`' {
+			unquoted, err := strconv.Unquote(dir)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: bad quoted path %s", filename, lineNum, dir)
+			}
+			dir = unquoted
+		} else {
+			dir = strings.Fields(dir)[0]
+		}
+		dirs = append(dirs, filepath.Join(filepath.Dir(filename), filepath.FromSlash(dir)))
+	}
+	return dirs, s.Err()
+}
@@ -0,0 +1,134 @@
+package linter
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadWorkspaceModules(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+		err  string
+	}{
+		{
+			name: "use lines",
+			data: "go 1.22\n\nuse ./a\nuse\t./b // comment\nuse ../outside\n",
+			want: []string{"a", "b", "../outside"},
+		},
+		{
+			name: "use block",
+			data: "go 1.22\n\nuse (\n\t./a\n\n\t// ./commented\n\t./a/nested // nested module\n\t.\n)\n\nuse ./c\n",
+			want: []string{"a", "a/nested", ".", "c"},
+		},
+		{
+			name: "quoted",
+			data: "use (\n\t\"./with space\"\n\t`./raw`\n)\nuse \"./x\"\n",
+			want: []string{"with space", "raw", "x"},
+		},
+		{
+			name: "bad quoting",
+			data: "use (\n\t./a\n\t\"./b\n)\n",
+			err:  "go.work:3: bad quoted path",
+		},
+		{
+			name: "other directives",
+			data: "go 1.22\ntoolchain go1.22.1\nreplace example.com/a => ./replaced\ngodebug (\n\tpanicnil=1\n)\nuse ./a\n",
+			want: []string{"a"},
+		},
+		{
+			name: "no use",
+			data: "go 1.22\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			filename := filepath.Join(dir, "go.work")
+			if err := os.WriteFile(filename, []byte(test.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			have, err := readWorkspaceModules(filename)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("have %v error, want %q", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []string
+			for _, d := range test.want {
+				want = append(want, filepath.Join(dir, filepath.FromSlash(d)))
+			}
+			if !slices.Equal(have, want) {
+				t.Errorf("have %q\nwant %q", have, want)
+			}
+		})
+	}
+}
+
+func TestExpandPathsWorkspace(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "root")
+	files := map[string]string{
+		"root/go.work":         "go 1.22\n\nuse (\n\t./a\n\t./a/nested\n\t../outside\n)\n",
+		"root/a/go.mod":        "module example.com/a\n",
+		"root/a/a.go":          "package a\n",
+		"root/a/pkg/b.go":      "package b\n",
+		"root/a/nested/go.mod": "module example.com/nested\n",
+		"root/a/nested/c.go":   "package c\n",
+		"root/a/other/go.mod":  "module example.com/other\n",
+		"root/a/other/d.go":    "package d\n",
+		"root/unused/e.go":     "package e\n",
+		"outside/go.mod":       "module example.com/outside\n",
+		"outside/f.go":         "package f\n",
+	}
+	for name, data := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("GOWORK", "")
+
+	l := &linter{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	have, err := l.expandPaths([]string{filepath.Join(root, "...")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The nested workspace module is checked once, as its own module,
+	// the nested non-workspace module and the dirs outside of the
+	// modules are not checked.
+	want := []string{"root/a", "root/a/pkg", "root/a/nested", "outside"}
+	for i := range want {
+		want[i] = filepath.Join(dir, filepath.FromSlash(want[i]))
+	}
+	if !slices.Equal(have, want) {
+		t.Errorf("have %q\nwant %q", have, want)
+	}
+
+	t.Setenv("GOWORK", "off")
+	have, err = l.expandPaths([]string{filepath.Join(root, "...")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Outside of any module, all packages are matched.
+	want = []string{"root/a", "root/a/nested", "root/a/other", "root/a/pkg", "root/unused"}
+	for i := range want {
+		want[i] = filepath.Join(dir, filepath.FromSlash(want[i]))
+	}
+	if !slices.Equal(have, want) {
+		t.Errorf("GOWORK=off:\nhave %q\nwant %q", have, want)
+	}
+}
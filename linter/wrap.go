@@ -0,0 +1,125 @@
+package linter
+
+import (
+	"go/ast"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// linkDefRegexp matches the go/doc "[Text]: URL" link definitions.
+var linkDefRegexp = regexp.MustCompile(`^\[[^\]]+\]: `)
+
+// checkWrap reports the doc-comment prose paragraphs with the lines
+// longer than wrap_width, counting from the // marker. With -fix,
+// such paragraphs are reflowed to fit the width.
+//
+// Only the plain text paragraphs are wrapped: code blocks, lists,
+// headings, link definitions, directives and the Deprecated
+// paragraphs are kept as they are.
+func (c *checker) checkWrap(doc *ast.CommentGroup) {
+	if c.wrapWidth <= 0 {
+		return
+	}
+	var paragraph []*ast.Comment
+	flush := func() {
+		if len(paragraph) != 0 {
+			c.checkWrapParagraph(paragraph)
+		}
+		paragraph = nil
+	}
+	for _, comment := range doc.List {
+		if !strings.HasPrefix(comment.Text, "//") {
+			return
+		}
+		text := strings.TrimPrefix(comment.Text[len("//"):], " ")
+		if isDirective(comment.Text) || strings.TrimSpace(text) == "" || isIndented(text) {
+			// Code blocks and lists are indented, they end the paragraph.
+			flush()
+			continue
+		}
+		paragraph = append(paragraph, comment)
+	}
+	flush()
+}
+
+func (c *checker) checkWrapParagraph(paragraph []*ast.Comment) {
+	var long *ast.Comment
+	var words []string
+	for _, comment := range paragraph {
+		text := strings.TrimPrefix(comment.Text[len("//"):], " ")
+		if !isProseLine(text) {
+			return
+		}
+		if long == nil && utf8.RuneCountInString(comment.Text) > c.wrapWidth {
+			long = comment
+		}
+		words = append(words, strings.Fields(text)...)
+	}
+	first := strings.TrimPrefix(paragraph[0].Text[len("//"):], " ")
+	if long == nil || strings.HasPrefix(first, "Deprecated: ") {
+		return
+	}
+
+	lines := wrapWords(words, c.wrapWidth-len("// "))
+	if len(lines) == len(paragraph) {
+		same := true
+		for i, line := range lines {
+			same = same && paragraph[i].Text == "// "+line
+		}
+		if same {
+			// The long lines are single words, like URLs.
+			return
+		}
+	}
+
+	start := c.fset.Position(paragraph[0].Pos())
+	end := c.fset.Position(paragraph[len(paragraph)-1].End())
+	if src := c.sources[start.Filename]; src != nil {
+		indent := string(src[start.Offset-(start.Column-1) : start.Offset])
+		c.suggestFix(textEdit{
+			check:    "wrap",
+			filename: start.Filename,
+			start:    start.Offset,
+			end:      end.Offset,
+			newText:  "// " + strings.Join(lines, "\n"+indent+"// "),
+		})
+	}
+	c.warnComment("wrap", long, "line is %d characters long, wrap the paragraph to %d characters",
+		utf8.RuneCountInString(long.Text), c.wrapWidth)
+}
+
+// isProseLine reports whether the unindented doc line is a part
+// of a plain text paragraph that can be reflowed.
+func isProseLine(text string) bool {
+	return !listMarkerRegexp.MatchString(text) &&
+		!headingRegexp.MatchString(text) &&
+		!linkDefRegexp.MatchString(text) &&
+		!strings.HasPrefix(strings.TrimSpace(text), "```")
+}
+
+// wrapWords fills the lines with the words, so that every line is at
+// most width long. The words that are longer than width get own lines.
+func wrapWords(words []string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	lineLen := 0
+	for _, word := range words {
+		n := utf8.RuneCountInString(word)
+		if lineLen != 0 && lineLen+1+n > width {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineLen = 0
+		}
+		if lineLen != 0 {
+			line.WriteByte(' ')
+			lineLen++
+		}
+		line.WriteString(word)
+		lineLen += n
+	}
+	if lineLen != 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}